@@ -0,0 +1,403 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/md5" // #nosec G501
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The content type of a directory uploaded by UploadAttachment.
+const AttachmentContentTypeZipConst = "application/zip"
+
+// UploadAttachmentOptions : The UploadAttachment options.
+type UploadAttachmentOptions struct {
+	// The name of the attachment. Defaults to the base name of the path, followed by ".zip" for a directory.
+	Filename string
+
+	// The content type of the attachment. Defaults to "application/zip" for a directory; for a file, the content
+	// type is detected from its first bytes and, if they are not conclusive, from its extension.
+	ContentType string
+
+	// The maximum size of the attachment in bytes. If not set, the size is not limited.
+	MaxSize int64
+
+	// An optional callback invoked as the attachment is uploaded, with the number of bytes sent so far and the
+	// size of the attachment, which is -1 for a directory.
+	Progress func(sent int64, total int64)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// UploadAttachment attaches the file or directory at "path" to the case identified by "caseNumber".
+// A directory is zipped as it is uploaded, without creating a temporary file. An error is returned before anything
+// is sent if a file is larger than MaxSize; a directory whose zip archive exceeds MaxSize is detected while it is
+// uploaded, and the upload is then cancelled.
+func (caseManagement *CaseManagementV1) UploadAttachment(ctx context.Context, caseNumber string, path string, opts *UploadAttachmentOptions) (*Attachment, error) {
+	if opts == nil {
+		opts = &UploadAttachmentOptions{}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := opts.Filename
+	contentType := opts.ContentType
+	total := int64(-1)
+	var data io.ReadCloser
+	if info.IsDir() {
+		if filename == "" {
+			filename = filepath.Base(filepath.Clean(path)) + ".zip"
+		}
+		if contentType == "" {
+			contentType = AttachmentContentTypeZipConst
+		}
+		data = zipDirectory(path)
+	} else {
+		if !info.Mode().IsRegular() {
+			return nil, fmt.Errorf("'%s' is not a regular file or a directory", path)
+		}
+		total = info.Size()
+		if opts.MaxSize > 0 && total > opts.MaxSize {
+			return nil, fmt.Errorf("the size of '%s' (%d bytes) exceeds the limit of %d bytes", path, total, opts.MaxSize)
+		}
+		if filename == "" {
+			filename = filepath.Base(path)
+		}
+		file, err := os.Open(path) // #nosec G304
+		if err != nil {
+			return nil, err
+		}
+		buffered := bufio.NewReader(file)
+		if contentType == "" {
+			contentType = detectContentType(buffered, filename)
+		}
+		data = struct {
+			io.Reader
+			io.Closer
+		}{buffered, file}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	counter := &uploadCounter{ReadCloser: data, total: total, maxSize: opts.MaxSize, progress: opts.Progress, cancel: cancel}
+	defer counter.Close() // #nosec G307
+
+	options := caseManagement.NewUploadFileOptions(caseNumber, []FileWithMetadata{{
+		Data:        counter,
+		Filename:    core.StringPtr(filename),
+		ContentType: core.StringPtr(contentType),
+	}})
+	options.SetHeaders(opts.Headers)
+	attachment, _, err := caseManagement.UploadFileWithContext(ctx, options)
+	if counterErr := counter.failure(); counterErr != nil {
+		return nil, counterErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// detectContentType returns the content type of a file from its first bytes, or from its name if they are
+// not conclusive.
+func detectContentType(reader *bufio.Reader, filename string) string {
+	head, _ := reader.Peek(512)
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" || strings.HasPrefix(contentType, "text/plain") {
+		if byExtension := mime.TypeByExtension(filepath.Ext(filename)); byExtension != "" {
+			return byExtension
+		}
+	}
+	return contentType
+}
+
+// zipDirectory returns a reader of a zip archive of the files in "dir", which is written as it is read.
+func zipDirectory(dir string) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		archive := zip.NewWriter(writer)
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path == dir || !(entry.IsDir() || entry.Type().IsRegular()) {
+				return nil
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			header, err := zip.FileInfoHeader(info)
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			header.Name = filepath.ToSlash(name)
+			if entry.IsDir() {
+				header.Name += "/"
+				_, err = archive.CreateHeader(header)
+				return err
+			}
+			header.Method = zip.Deflate
+			w, err := archive.CreateHeader(header)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(path) // #nosec G304
+			if err != nil {
+				return err
+			}
+			defer file.Close() // #nosec G307
+			_, err = io.Copy(w, file)
+			return err
+		})
+		if err == nil {
+			err = archive.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+	return reader
+}
+
+// uploadCounter : Counts the bytes of an attachment as they are read for the upload, reports the progress and
+// cancels the upload if the attachment exceeds its maximum size.
+type uploadCounter struct {
+	io.ReadCloser
+	total    int64
+	maxSize  int64
+	progress func(sent int64, total int64)
+	cancel   context.CancelFunc
+
+	sent int64
+
+	// The upload is read by a goroutine of the request builder.
+	mu  sync.Mutex
+	err error
+}
+
+func (counter *uploadCounter) fail(err error) error {
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	counter.err = err
+	counter.cancel()
+	return err
+}
+
+func (counter *uploadCounter) failure() error {
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	return counter.err
+}
+
+func (counter *uploadCounter) Read(p []byte) (int, error) {
+	n, err := counter.ReadCloser.Read(p)
+	counter.sent += int64(n)
+	if counter.maxSize > 0 && counter.sent > counter.maxSize {
+		return n, counter.fail(fmt.Errorf("the size of the attachment exceeds the limit of %d bytes", counter.maxSize))
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		counter.fail(fmt.Errorf("error reading the attachment: %w", err))
+	}
+	if n > 0 && counter.progress != nil {
+		counter.progress(counter.sent, counter.total)
+	}
+	return n, err
+}
+
+// DownloadAttachmentsOptions : The DownloadAttachments options.
+type DownloadAttachmentsOptions struct {
+	// An optional callback invoked as each attachment is downloaded, with the number of bytes received so far.
+	Progress func(attachment *Attachment, received int64)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// DownloadedAttachment : An attachment saved by DownloadAttachments.
+type DownloadedAttachment struct {
+	// The attachment.
+	Attachment Attachment
+
+	// The path of the file holding the attachment.
+	Path string
+
+	// The size of the file in bytes.
+	Size int64
+
+	// The hex-encoded SHA-256 checksum of the file.
+	SHA256 string
+}
+
+// DownloadAttachments saves all the attachments of the case identified by "caseNumber" to files in "dir", which
+// is created if needed. The files are named after the attachments; if several attachments have the same name,
+// the name of the files is prefixed with the ID of the attachment. Existing files are replaced.
+//
+// The size of each attachment is verified against the size reported for the case, as well as its checksum against
+// the Content-MD5 or Digest (sha-256) header of the download, if provided. A file is only written once its
+// attachment has been verified. The attachments are downloaded in order; on error, the attachments downloaded
+// so far are returned along with the error.
+func (caseManagement *CaseManagementV1) DownloadAttachments(ctx context.Context, caseNumber string, dir string, opts *DownloadAttachmentsOptions) ([]DownloadedAttachment, error) {
+	if opts == nil {
+		opts = &DownloadAttachmentsOptions{}
+	}
+	getCaseOptions := caseManagement.NewGetCaseOptions(caseNumber)
+	getCaseOptions.SetFields([]string{GetCaseOptionsFieldsAttachmentsConst})
+	getCaseOptions.SetHeaders(opts.Headers)
+	caseResult, _, err := caseManagement.GetCaseWithContext(ctx, getCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]int)
+	for _, attachment := range caseResult.Attachments {
+		names[attachmentFilename(&attachment)]++
+	}
+	var downloaded []DownloadedAttachment
+	for i := range caseResult.Attachments {
+		attachment := &caseResult.Attachments[i]
+		name := attachmentFilename(attachment)
+		if names[name] > 1 {
+			name = core.StringNilMapper(attachment.ID) + "-" + name
+		}
+		result, err := caseManagement.downloadAttachment(ctx, caseNumber, attachment, filepath.Join(dir, name), opts)
+		if err != nil {
+			return downloaded, fmt.Errorf("error downloading attachment '%s': %w", core.StringNilMapper(attachment.Filename), err)
+		}
+		downloaded = append(downloaded, *result)
+	}
+	return downloaded, nil
+}
+
+// attachmentFilename returns the name of the file for "attachment", stripped of any directory.
+func attachmentFilename(attachment *Attachment) string {
+	name := filepath.Base(filepath.Clean(string(filepath.Separator) + core.StringNilMapper(attachment.Filename)))
+	if name == string(filepath.Separator) || name == "." {
+		name = core.StringNilMapper(attachment.ID)
+	}
+	return name
+}
+
+func (caseManagement *CaseManagementV1) downloadAttachment(ctx context.Context, caseNumber string, attachment *Attachment, path string, opts *DownloadAttachmentsOptions) (*DownloadedAttachment, error) {
+	downloadOptions := caseManagement.NewDownloadFileOptions(caseNumber, core.StringNilMapper(attachment.ID))
+	downloadOptions.SetHeaders(opts.Headers)
+	body, response, err := caseManagement.DownloadFileWithContext(ctx, downloadOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close() // #nosec G307
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name()) // #nosec G307
+	defer file.Close()           // #nosec G307
+
+	sha := sha256.New()
+	md := md5.New() // #nosec G401
+	writer := io.MultiWriter(file, sha, md)
+	if opts.Progress != nil {
+		writer = &downloadProgress{Writer: writer, attachment: attachment, progress: opts.Progress}
+	}
+	size, err := io.Copy(writer, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.SizeInBytes != nil && *attachment.SizeInBytes != size {
+		return nil, fmt.Errorf("received %d bytes instead of %d", size, *attachment.SizeInBytes)
+	}
+	if err = verifyChecksum(response.GetHeaders(), md, sha); err != nil {
+		return nil, err
+	}
+	if err = file.Sync(); err != nil {
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(file.Name(), path); err != nil {
+		return nil, err
+	}
+	return &DownloadedAttachment{
+		Attachment: *attachment,
+		Path:       path,
+		Size:       size,
+		SHA256:     hex.EncodeToString(sha.Sum(nil)),
+	}, nil
+}
+
+// verifyChecksum compares the checksums of a download with those of its Content-MD5 and Digest headers.
+func verifyChecksum(headers http.Header, md hash.Hash, sha hash.Hash) error {
+	if expected := strings.TrimSpace(headers.Get("Content-MD5")); expected != "" {
+		if actual := base64.StdEncoding.EncodeToString(md.Sum(nil)); actual != expected {
+			return fmt.Errorf("MD5 checksum mismatch: expected %s, got %s", expected, actual)
+		}
+	}
+	for _, digest := range strings.Split(headers.Get("Digest"), ",") {
+		algorithm, expected, found := strings.Cut(strings.TrimSpace(digest), "=")
+		if !found || !strings.EqualFold(algorithm, "sha-256") {
+			continue
+		}
+		if actual := base64.StdEncoding.EncodeToString(sha.Sum(nil)); actual != expected {
+			return fmt.Errorf("SHA-256 checksum mismatch: expected %s, got %s", expected, actual)
+		}
+	}
+	return nil
+}
+
+// downloadProgress : Reports the progress of the download of an attachment.
+type downloadProgress struct {
+	io.Writer
+	attachment *Attachment
+	progress   func(attachment *Attachment, received int64)
+	received   int64
+}
+
+func (p *downloadProgress) Write(b []byte) (int, error) {
+	n, err := p.Writer.Write(b)
+	p.received += int64(n)
+	p.progress(p.attachment, p.received)
+	return n, err
+}
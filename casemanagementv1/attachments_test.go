@@ -0,0 +1,239 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec G501
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Case attachments`, func() {
+	var testServer *httptest.Server
+	var caseManagementService *casemanagementv1.CaseManagementV1
+	var dir string
+
+	newService := func(handler http.HandlerFunc) {
+		testServer = httptest.NewServer(handler)
+		var err error
+		caseManagementService, err = casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	}
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "attachments")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+		os.RemoveAll(dir)
+	})
+
+	Describe(`UploadAttachment`, func() {
+		type upload struct {
+			filename    string
+			contentType string
+			content     []byte
+		}
+		var uploads []upload
+		BeforeEach(func() {
+			uploads = nil
+			newService(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				Expect(req.Method).To(Equal(http.MethodPut))
+				Expect(req.URL.Path).To(Equal("/cases/CS0001/attachments"))
+				file, header, err := req.FormFile("file")
+				if err != nil {
+					res.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				content, err := io.ReadAll(file)
+				Expect(err).To(BeNil())
+				uploads = append(uploads, upload{header.Filename, header.Header.Get("Content-Type"), content})
+				res.Header().Set("Content-type", "application/json")
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"id": "f1", "filename": header.Filename, "size_in_bytes": len(content)})).To(Succeed())
+			})
+		})
+
+		It(`Uploads a file with its detected content type and reports progress`, func() {
+			path := filepath.Join(dir, "trace")
+			content := bytes.Repeat([]byte("GET /v1/cases 200\n"), 1000)
+			Expect(os.WriteFile(path, content, 0600)).To(Succeed())
+
+			var progress []int64
+			attachment, err := caseManagementService.UploadAttachment(context.Background(), "CS0001", path, &casemanagementv1.UploadAttachmentOptions{
+				Progress: func(sent int64, total int64) {
+					Expect(total).To(Equal(int64(len(content))))
+					progress = append(progress, sent)
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(*attachment.SizeInBytes).To(Equal(int64(len(content))))
+			Expect(uploads).To(HaveLen(1))
+			Expect(uploads[0].filename).To(Equal("trace"))
+			Expect(uploads[0].contentType).To(Equal("text/plain; charset=utf-8"))
+			Expect(uploads[0].content).To(Equal(content))
+			Expect(progress).ToNot(BeEmpty())
+			Expect(progress[len(progress)-1]).To(Equal(int64(len(content))))
+
+			pngPath := filepath.Join(dir, "screenshot")
+			Expect(os.WriteFile(pngPath, []byte("\x89PNG\x0D\x0A\x1A\x0A rest of the image"), 0600)).To(Succeed())
+			_, err = caseManagementService.UploadAttachment(context.Background(), "CS0001", pngPath, &casemanagementv1.UploadAttachmentOptions{Filename: "screen.png"})
+			Expect(err).To(BeNil())
+			Expect(uploads[1].filename).To(Equal("screen.png"))
+			Expect(uploads[1].contentType).To(Equal("image/png"))
+		})
+		It(`Zips a directory`, func() {
+			logs := filepath.Join(dir, "logs")
+			Expect(os.MkdirAll(filepath.Join(logs, "app", "empty"), 0750)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(logs, "system.log"), []byte("boot"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(logs, "app", "app.log"), []byte("started"), 0600)).To(Succeed())
+
+			_, err := caseManagementService.UploadAttachment(context.Background(), "CS0001", logs, nil)
+			Expect(err).To(BeNil())
+			Expect(uploads[0].filename).To(Equal("logs.zip"))
+			Expect(uploads[0].contentType).To(Equal(casemanagementv1.AttachmentContentTypeZipConst))
+
+			archive, err := zip.NewReader(bytes.NewReader(uploads[0].content), int64(len(uploads[0].content)))
+			Expect(err).To(BeNil())
+			files := make(map[string]string)
+			for _, file := range archive.File {
+				reader, err := file.Open()
+				Expect(err).To(BeNil())
+				content, err := io.ReadAll(reader)
+				Expect(err).To(BeNil())
+				files[file.Name] = string(content)
+			}
+			Expect(files).To(Equal(map[string]string{"app/": "", "app/app.log": "started", "app/empty/": "", "system.log": "boot"}))
+		})
+		It(`Enforces the maximum size`, func() {
+			path := filepath.Join(dir, "dump.bin")
+			Expect(os.WriteFile(path, make([]byte, 2048), 0600)).To(Succeed())
+			_, err := caseManagementService.UploadAttachment(context.Background(), "CS0001", path, &casemanagementv1.UploadAttachmentOptions{MaxSize: 1024})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("exceeds the limit of 1024 bytes"))
+
+			large := filepath.Join(dir, "large")
+			Expect(os.MkdirAll(large, 0750)).To(Succeed())
+			random := make([]byte, 64*1024)
+			for i := range random {
+				random[i] = byte(i * 7919 >> 3)
+			}
+			Expect(os.WriteFile(filepath.Join(large, "data.bin"), random, 0600)).To(Succeed())
+			_, err = caseManagementService.UploadAttachment(context.Background(), "CS0001", large, &casemanagementv1.UploadAttachmentOptions{MaxSize: 1024})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("exceeds the limit of 1024 bytes"))
+			Expect(uploads).To(BeEmpty())
+
+			_, err = caseManagementService.UploadAttachment(context.Background(), "CS0001", filepath.Join(dir, "missing"), nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`DownloadAttachments`, func() {
+		files := map[string][]byte{
+			"f1": []byte("first report"),
+			"f2": []byte("second report"),
+			"f3": []byte("notes"),
+		}
+		var corrupt string
+		BeforeEach(func() {
+			corrupt = ""
+			newService(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				Expect(req.Method).To(Equal(http.MethodGet))
+				if req.URL.Path == "/cases/CS0001" {
+					Expect(req.URL.Query().Get("fields")).To(Equal("attachments"))
+					res.Header().Set("Content-type", "application/json")
+					Expect(json.NewEncoder(res).Encode(map[string]interface{}{"number": "CS0001", "attachments": []interface{}{
+						map[string]interface{}{"id": "f1", "filename": "report.txt", "size_in_bytes": len(files["f1"])},
+						map[string]interface{}{"id": "f2", "filename": "../report.txt", "size_in_bytes": len(files["f2"])},
+						map[string]interface{}{"id": "f3", "filename": "notes.txt", "size_in_bytes": len(files["f3"])},
+					}})).To(Succeed())
+					return
+				}
+				id := strings.TrimPrefix(req.URL.Path, "/cases/CS0001/attachments/")
+				content := files[id]
+				sum := md5.Sum(content) // #nosec G401
+				res.Header().Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+				res.Header().Set("Content-type", "application/octet-stream")
+				if id == corrupt {
+					content = []byte(strings.ToUpper(string(content)))
+				}
+				_, err := res.Write(content)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		It(`Saves and verifies all the attachments`, func() {
+			target := filepath.Join(dir, "case")
+			received := make(map[string]int64)
+			downloaded, err := caseManagementService.DownloadAttachments(context.Background(), "CS0001", target, &casemanagementv1.DownloadAttachmentsOptions{
+				Progress: func(attachment *casemanagementv1.Attachment, n int64) { received[*attachment.ID] = n },
+			})
+			Expect(err).To(BeNil())
+			Expect(downloaded).To(HaveLen(3))
+			Expect(received).To(Equal(map[string]int64{"f1": 12, "f2": 13, "f3": 5}))
+
+			Expect(downloaded[0].Path).To(Equal(filepath.Join(target, "f1-report.txt")))
+			Expect(downloaded[1].Path).To(Equal(filepath.Join(target, "f2-report.txt")))
+			Expect(downloaded[2].Path).To(Equal(filepath.Join(target, "notes.txt")))
+			for i, id := range []string{"f1", "f2", "f3"} {
+				content, err := os.ReadFile(downloaded[i].Path)
+				Expect(err).To(BeNil())
+				Expect(content).To(Equal(files[id]))
+				sum := sha256.Sum256(files[id])
+				Expect(downloaded[i].SHA256).To(Equal(hex.EncodeToString(sum[:])))
+				Expect(downloaded[i].Size).To(Equal(int64(len(files[id]))))
+			}
+			entries, err := os.ReadDir(target)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(3))
+		})
+		It(`Rejects an attachment whose checksum does not match`, func() {
+			corrupt = "f2"
+			downloaded, err := caseManagementService.DownloadAttachments(context.Background(), "CS0001", dir, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("MD5 checksum mismatch"))
+			Expect(downloaded).To(HaveLen(1))
+			entries, err := os.ReadDir(dir)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -2552,3 +2553,10 @@ func (pager *GetCasesPager) GetNext() (page []Case, err error) {
 func (pager *GetCasesPager) GetAll() (allItems []Case, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetCasesPager) Items(ctx context.Context) iter.Seq2[Case, error] {
+	return common.Items[Case](ctx, pager)
+}
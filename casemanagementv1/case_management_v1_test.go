@@ -520,6 +520,33 @@ var _ = Describe(`CaseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetCasesPager.Items successfully`, func() {
+				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(caseManagementService).ToNot(BeNil())
+
+				getCasesOptionsModel := &casemanagementv1.GetCasesOptions{
+					Limit: core.Int64Ptr(int64(10)),
+					Search: core.StringPtr("testString"),
+					Sort: core.StringPtr("number"),
+					Status: []string{"new"},
+					Fields: []string{"number"},
+				}
+
+				pager, err := caseManagementService.NewGetCasesPager(getCasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []casemanagementv1.Case
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateCase(createCaseOptions *CreateCaseOptions) - Operation response error`, func() {
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -26402,6 +26403,13 @@ func (pager *CatalogAccountAuditsPager) GetAll() (allItems []AuditLogDigest, err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *CatalogAccountAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
+
 // GetShareApprovalListPager can be used to simplify the use of the "GetShareApprovalList" method.
 type GetShareApprovalListPager struct {
 	hasNext     bool
@@ -26487,6 +26495,13 @@ func (pager *GetShareApprovalListPager) GetAll() (allItems []ShareApprovalAccess
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetShareApprovalListPager) Items(ctx context.Context) iter.Seq2[ShareApprovalAccess, error] {
+	return common.Items[ShareApprovalAccess](ctx, pager)
+}
+
 // GetShareApprovalListAsSourcePager can be used to simplify the use of the "GetShareApprovalListAsSource" method.
 type GetShareApprovalListAsSourcePager struct {
 	hasNext     bool
@@ -26572,6 +26587,13 @@ func (pager *GetShareApprovalListAsSourcePager) GetAll() (allItems []ShareApprov
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetShareApprovalListAsSourcePager) Items(ctx context.Context) iter.Seq2[ShareApprovalAccess, error] {
+	return common.Items[ShareApprovalAccess](ctx, pager)
+}
+
 // CatalogAuditsPager can be used to simplify the use of the "ListCatalogAudits" method.
 type CatalogAuditsPager struct {
 	hasNext     bool
@@ -26657,6 +26679,13 @@ func (pager *CatalogAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *CatalogAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
+
 // EnterpriseAuditsPager can be used to simplify the use of the "ListEnterpriseAudits" method.
 type EnterpriseAuditsPager struct {
 	hasNext     bool
@@ -26742,6 +26771,13 @@ func (pager *EnterpriseAuditsPager) GetAll() (allItems []AuditLogDigest, err err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *EnterpriseAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
+
 // GetConsumptionOfferingsPager can be used to simplify the use of the "GetConsumptionOfferings" method.
 type GetConsumptionOfferingsPager struct {
 	hasNext     bool
@@ -26834,6 +26870,13 @@ func (pager *GetConsumptionOfferingsPager) GetAll() (allItems []Offering, err er
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetConsumptionOfferingsPager) Items(ctx context.Context) iter.Seq2[Offering, error] {
+	return common.Items[Offering](ctx, pager)
+}
+
 // OfferingsPager can be used to simplify the use of the "ListOfferings" method.
 type OfferingsPager struct {
 	hasNext     bool
@@ -26926,6 +26969,13 @@ func (pager *OfferingsPager) GetAll() (allItems []Offering, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *OfferingsPager) Items(ctx context.Context) iter.Seq2[Offering, error] {
+	return common.Items[Offering](ctx, pager)
+}
+
 // OfferingAuditsPager can be used to simplify the use of the "ListOfferingAudits" method.
 type OfferingAuditsPager struct {
 	hasNext     bool
@@ -27011,6 +27061,13 @@ func (pager *OfferingAuditsPager) GetAll() (allItems []AuditLogDigest, err error
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *OfferingAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
+
 // GetOfferingAccessListPager can be used to simplify the use of the "GetOfferingAccessList" method.
 type GetOfferingAccessListPager struct {
 	hasNext     bool
@@ -27096,6 +27153,13 @@ func (pager *GetOfferingAccessListPager) GetAll() (allItems []Access, err error)
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetOfferingAccessListPager) Items(ctx context.Context) iter.Seq2[Access, error] {
+	return common.Items[Access](ctx, pager)
+}
+
 // GetVersionsPager can be used to simplify the use of the "GetVersions" method.
 type GetVersionsPager struct {
 	hasNext     bool
@@ -27181,6 +27245,13 @@ func (pager *GetVersionsPager) GetAll() (allItems []Version, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetVersionsPager) Items(ctx context.Context) iter.Seq2[Version, error] {
+	return common.Items[Version](ctx, pager)
+}
+
 // GetNamespacesPager can be used to simplify the use of the "GetNamespaces" method.
 type GetNamespacesPager struct {
 	hasNext     bool
@@ -27273,6 +27344,13 @@ func (pager *GetNamespacesPager) GetAll() (allItems []string, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetNamespacesPager) Items(ctx context.Context) iter.Seq2[string, error] {
+	return common.Items[string](ctx, pager)
+}
+
 // SearchObjectsPager can be used to simplify the use of the "SearchObjects" method.
 type SearchObjectsPager struct {
 	hasNext     bool
@@ -27365,6 +27443,13 @@ func (pager *SearchObjectsPager) GetAll() (allItems []CatalogObject, err error)
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *SearchObjectsPager) Items(ctx context.Context) iter.Seq2[CatalogObject, error] {
+	return common.Items[CatalogObject](ctx, pager)
+}
+
 // ObjectsPager can be used to simplify the use of the "ListObjects" method.
 type ObjectsPager struct {
 	hasNext     bool
@@ -27457,6 +27542,13 @@ func (pager *ObjectsPager) GetAll() (allItems []CatalogObject, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ObjectsPager) Items(ctx context.Context) iter.Seq2[CatalogObject, error] {
+	return common.Items[CatalogObject](ctx, pager)
+}
+
 // ObjectAuditsPager can be used to simplify the use of the "ListObjectAudits" method.
 type ObjectAuditsPager struct {
 	hasNext     bool
@@ -27542,6 +27634,13 @@ func (pager *ObjectAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ObjectAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
+
 // GetObjectAccessListPager can be used to simplify the use of the "GetObjectAccessList" method.
 type GetObjectAccessListPager struct {
 	hasNext     bool
@@ -27627,6 +27726,13 @@ func (pager *GetObjectAccessListPager) GetAll() (allItems []Access, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetObjectAccessListPager) Items(ctx context.Context) iter.Seq2[Access, error] {
+	return common.Items[Access](ctx, pager)
+}
+
 // GetObjectAccessListDeprecatedPager can be used to simplify the use of the "GetObjectAccessListDeprecated" method.
 type GetObjectAccessListDeprecatedPager struct {
 	hasNext     bool
@@ -27719,6 +27825,13 @@ func (pager *GetObjectAccessListDeprecatedPager) GetAll() (allItems []Access, er
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetObjectAccessListDeprecatedPager) Items(ctx context.Context) iter.Seq2[Access, error] {
+	return common.Items[Access](ctx, pager)
+}
+
 // OfferingInstanceAuditsPager can be used to simplify the use of the "ListOfferingInstanceAudits" method.
 type OfferingInstanceAuditsPager struct {
 	hasNext     bool
@@ -27803,3 +27916,10 @@ func (pager *OfferingInstanceAuditsPager) GetAll() (allItems []AuditLogDigest, e
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *OfferingInstanceAuditsPager) Items(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.Items[AuditLogDigest](ctx, pager)
+}
@@ -1192,6 +1192,30 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAccountAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAccountAuditsOptionsModel := &catalogmanagementv1.ListCatalogAccountAuditsOptions{
+					Limit:       core.Int64Ptr(int64(10)),
+					Lookupnames: core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAccountAuditsPager(listCatalogAccountAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCatalogAccountAudit(getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) - Operation response error`, func() {
@@ -1939,6 +1963,30 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListOptionsModel := &catalogmanagementv1.GetShareApprovalListOptions{
+					ObjectType: core.StringPtr("offering"),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListPager(getShareApprovalListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteShareApprovalList(deleteShareApprovalListOptions *DeleteShareApprovalListOptions) - Operation response error`, func() {
@@ -2773,6 +2821,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListAsSourcePager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListAsSourceOptionsModel := &catalogmanagementv1.GetShareApprovalListAsSourceOptions{
+					ObjectType:              core.StringPtr("offering"),
+					ApprovalStateIdentifier: core.StringPtr("approved"),
+					Limit:                   core.Int64Ptr(int64(10)),
+					EnterpriseID:            core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListAsSourcePager(getShareApprovalListAsSourceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`UpdateShareApprovalListAsSource(updateShareApprovalListAsSourceOptions *UpdateShareApprovalListAsSourceOptions) - Operation response error`, func() {
@@ -4910,6 +4984,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAuditsOptionsModel := &catalogmanagementv1.ListCatalogAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAuditsPager(listCatalogAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCatalogAudit(getCatalogAuditOptions *GetCatalogAuditOptions) - Operation response error`, func() {
@@ -5464,6 +5563,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterpriseAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listEnterpriseAuditsOptionsModel := &catalogmanagementv1.ListEnterpriseAuditsOptions{
+					EnterpriseIdentifier: core.StringPtr("testString"),
+					Limit:                core.Int64Ptr(int64(10)),
+					Lookupnames:          core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewEnterpriseAuditsPager(listEnterpriseAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetEnterpriseAudit(getEnterpriseAuditOptions *GetEnterpriseAuditOptions) - Operation response error`, func() {
@@ -6048,6 +6172,33 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetConsumptionOfferingsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getConsumptionOfferingsOptionsModel := &catalogmanagementv1.GetConsumptionOfferingsOptions{
+					Digest:        core.BoolPtr(true),
+					Catalog:       core.StringPtr("testString"),
+					Select:        core.StringPtr("all"),
+					IncludeHidden: core.BoolPtr(true),
+					Limit:         core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetConsumptionOfferingsPager(getConsumptionOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListOfferings(listOfferingsOptions *ListOfferingsOptions) - Operation response error`, func() {
@@ -6421,6 +6572,34 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingsOptionsModel := &catalogmanagementv1.ListOfferingsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+					IncludeHidden:     core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingsPager(listOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateOffering(createOfferingOptions *CreateOfferingOptions) - Operation response error`, func() {
@@ -15210,6 +15389,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingAuditsOptionsModel := &catalogmanagementv1.ListOfferingAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingAuditsPager(listOfferingAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingAudit(getOfferingAuditOptions *GetOfferingAuditOptions) - Operation response error`, func() {
@@ -16918,6 +17123,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetOfferingAccessListPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getOfferingAccessListOptionsModel := &catalogmanagementv1.GetOfferingAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetOfferingAccessListPager(getOfferingAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteOfferingAccessList(deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) - Operation response error`, func() {
@@ -19020,6 +19250,34 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetVersionsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getVersionsOptionsModel := &catalogmanagementv1.GetVersionsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					KindID:            core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Catalog:           core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetVersionsPager(getVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Version
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingAbout(getOfferingAboutOptions *GetOfferingAboutOptions)`, func() {
@@ -25461,6 +25719,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetNamespacesPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getNamespacesOptionsModel := &catalogmanagementv1.GetNamespacesOptions{
+					ClusterID:         core.StringPtr("testString"),
+					Region:            core.StringPtr("testString"),
+					XAuthRefreshToken: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetNamespacesPager(getNamespacesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []string
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeployOperators(deployOperatorsOptions *DeployOperatorsOptions) - Operation response error`, func() {
@@ -27751,6 +28035,33 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use SearchObjectsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				searchObjectsOptionsModel := &catalogmanagementv1.SearchObjectsOptions{
+					Query:    core.StringPtr("testString"),
+					Kind:     core.StringPtr("vpe"),
+					Limit:    core.Int64Ptr(int64(10)),
+					Collapse: core.BoolPtr(true),
+					Digest:   core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewSearchObjectsPager(searchObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListObjects(listObjectsOptions *ListObjectsOptions) - Operation response error`, func() {
@@ -28104,6 +28415,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ObjectsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectsOptionsModel := &catalogmanagementv1.ListObjectsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewObjectsPager(listObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateObject(createObjectOptions *CreateObjectOptions) - Operation response error`, func() {
@@ -29630,6 +29967,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ObjectAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectAuditsOptionsModel := &catalogmanagementv1.ListObjectAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewObjectAuditsPager(listObjectAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetObjectAudit(getObjectAuditOptions *GetObjectAuditOptions) - Operation response error`, func() {
@@ -30520,6 +30883,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListOptionsModel := &catalogmanagementv1.GetObjectAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListPager(getObjectAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetObjectAccess(getObjectAccessOptions *GetObjectAccessOptions) - Operation response error`, func() {
@@ -31226,6 +31614,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListDeprecatedPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListDeprecatedOptionsModel := &catalogmanagementv1.GetObjectAccessListDeprecatedOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListDeprecatedPager(getObjectAccessListDeprecatedOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteObjectAccessList(deleteObjectAccessListOptions *DeleteObjectAccessListOptions) - Operation response error`, func() {
@@ -33252,6 +33665,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingInstanceAuditsPager.Items successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingInstanceAuditsOptionsModel := &catalogmanagementv1.ListOfferingInstanceAuditsOptions{
+					InstanceIdentifier: core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					Lookupnames:        core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingInstanceAuditsPager(listOfferingInstanceAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingInstanceAudit(getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) - Operation response error`, func() {
@@ -0,0 +1,283 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package crn parses, validates and builds Cloud Resource Names (CRNs) of the form:
+//
+//	crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+//
+// For example:
+//
+//	crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::
+package crn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+const (
+	// Prefix is the first segment of every CRN.
+	Prefix = "crn"
+
+	// Version1 is the current CRN version.
+	Version1 = "v1"
+
+	separator    = ":"
+	segmentCount = 10
+)
+
+// Scope types, used as the prefix of the scope segment (e.g. "a/<account id>").
+const (
+	ScopeTypeAccount      = "a"
+	ScopeTypeOrganization = "o"
+	ScopeTypeSpace        = "s"
+	ScopeTypeProject      = "p"
+)
+
+// CRN : The segments of a Cloud Resource Name.
+type CRN struct {
+	// The version of the CRN format (e.g. "v1").
+	Version string
+
+	// The cloud instance name (e.g. "bluemix", "staging").
+	CName string
+
+	// The cloud type (e.g. "public", "dedicated", "local").
+	CType string
+
+	// The name of the service (e.g. "cloud-object-storage").
+	ServiceName string
+
+	// The location of the resource (e.g. "us-south", "global").
+	Location string
+
+	// The scope of the resource, "<scope type>/<scope id>" (e.g. "a/59bcbfa6ea2f006b4ed7094c1a08dcdd").
+	Scope string
+
+	// The ID of the service instance.
+	ServiceInstance string
+
+	// The type of the resource within the service instance.
+	ResourceType string
+
+	// The ID of the resource within the service instance.
+	Resource string
+}
+
+// Parse parses "s" into a CRN and validates it.
+//
+// The resource segment is the remainder of the string after the ninth separator,
+// so it may itself contain colons.
+func Parse(s string) (c CRN, err error) {
+	c, err = split(s)
+	if err != nil {
+		return
+	}
+	if err = c.Validate(); err != nil {
+		err = fmt.Errorf("invalid CRN '%s': %w", s, err)
+		c = CRN{}
+	}
+	return
+}
+
+// ParsePtr is like Parse but accepts the *string form used by the SDK models.
+// It returns an error if "s" is nil.
+func ParsePtr(s *string) (CRN, error) {
+	if s == nil {
+		return CRN{}, errors.New("CRN is not set")
+	}
+	return Parse(*s)
+}
+
+// MustParse is like Parse but panics if "s" is not a valid CRN.
+// It is intended for use with constant CRNs in tests and initializers.
+func MustParse(s string) CRN {
+	c, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// IsValid returns true if "s" is a valid CRN.
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// Validate returns an error if the CRN is missing one of its required segments
+// (version, cname and ctype), if a segment contains whitespace, or if the scope
+// is not of the form "<scope type>/<scope id>".
+func (c CRN) Validate() error {
+	if c.Version == "" {
+		return errors.New("the version segment must be specified")
+	}
+	if c.CName == "" {
+		return errors.New("the cname segment must be specified")
+	}
+	if c.CType == "" {
+		return errors.New("the ctype segment must be specified")
+	}
+	for i, segment := range c.segments() {
+		if strings.ContainsAny(segment, " \t\r\n") {
+			return fmt.Errorf("segment %d must not contain whitespace", i+1)
+		}
+		// Only the trailing resource segment may contain the separator.
+		if i < segmentCount-1 && strings.Contains(segment, separator) {
+			return fmt.Errorf("segment %d must not contain '%s'", i+1, separator)
+		}
+	}
+	if c.Scope != "" {
+		scopeType, scopeID, found := strings.Cut(c.Scope, "/")
+		if !found || scopeType == "" || scopeID == "" {
+			return fmt.Errorf("the scope segment '%s' must be of the form '<scope type>/<scope id>'", c.Scope)
+		}
+	}
+	return nil
+}
+
+// String returns the string form of the CRN, or an empty string for the zero CRN.
+func (c CRN) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return strings.Join(c.segments(), separator)
+}
+
+// IsZero returns true if no segment of the CRN is set.
+func (c CRN) IsZero() bool {
+	return c == CRN{}
+}
+
+// ScopeType returns the type of the scope segment (e.g. "a" for an account), if any.
+func (c CRN) ScopeType() string {
+	scopeType, _, _ := strings.Cut(c.Scope, "/")
+	return scopeType
+}
+
+// ScopeID returns the ID of the scope segment (e.g. the account ID), if any.
+func (c CRN) ScopeID() string {
+	_, scopeID, _ := strings.Cut(c.Scope, "/")
+	return scopeID
+}
+
+// AccountID returns the account ID if the CRN is scoped to an account, or an empty string otherwise.
+func (c CRN) AccountID() string {
+	if c.ScopeType() != ScopeTypeAccount {
+		return ""
+	}
+	return c.ScopeID()
+}
+
+// WithAccountScope returns a copy of the CRN scoped to the specified account.
+func (c CRN) WithAccountScope(accountID string) CRN {
+	c.Scope = ScopeTypeAccount + "/" + accountID
+	return c
+}
+
+// Matches returns true if the CRN matches "pattern".
+//
+// The pattern is compared segment by segment: an empty pattern segment matches any value,
+// and each non-empty pattern segment is matched with common.WildcardMatch, so "*" matches any
+// value (including a scope such as "a/123"), "kms*" matches any value beginning with "kms"
+// and "?" matches any single character.
+func (c CRN) Matches(pattern CRN) bool {
+	segments := c.segments()
+	for i, patternSegment := range pattern.segments() {
+		if i == 0 || patternSegment == "" {
+			continue
+		}
+		if !common.WildcardMatch(patternSegment, segments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchString is like Matches but parses "pattern" first.
+// It returns false if "pattern" does not have the form of a CRN.
+func (c CRN) MatchString(pattern string) bool {
+	p, err := split(pattern)
+	if err != nil {
+		return false
+	}
+	return c.Matches(p)
+}
+
+// MarshalText implements encoding.TextMarshaler, so a CRN is encoded as a JSON string.
+func (c CRN) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so a CRN can be decoded from a JSON string.
+// An empty string is decoded as the zero CRN.
+func (c *CRN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = CRN{}
+		return nil
+	}
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// split splits "s" into the segments of a CRN, without validating them.
+func split(s string) (c CRN, err error) {
+	segments := strings.SplitN(s, separator, segmentCount)
+	if len(segments) != segmentCount {
+		err = fmt.Errorf("invalid CRN '%s': expected %d segments separated by '%s'", s, segmentCount, separator)
+		return
+	}
+	if segments[0] != Prefix {
+		err = fmt.Errorf("invalid CRN '%s': must begin with '%s%s'", s, Prefix, separator)
+		return
+	}
+
+	c = CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+	return
+}
+
+// segments returns all segments of the CRN, including the "crn" prefix.
+func (c CRN) segments() []string {
+	return []string{
+		Prefix,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Location,
+		c.Scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crn
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const instanceCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::"
+
+func TestParse(t *testing.T) {
+	c, err := Parse(instanceCRN)
+	assert.Nil(t, err)
+	assert.Equal(t, CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "cloud-object-storage",
+		Location:        "global",
+		Scope:           "a/59bcbfa6ea2f006b4ed7094c1a08dcdd",
+		ServiceInstance: "1a0ec336-f391-4091-a6fb-5e084a4c56f4",
+	}, c)
+	assert.Equal(t, instanceCRN, c.String())
+	assert.Equal(t, "a", c.ScopeType())
+	assert.Equal(t, "59bcbfa6ea2f006b4ed7094c1a08dcdd", c.ScopeID())
+	assert.Equal(t, "59bcbfa6ea2f006b4ed7094c1a08dcdd", c.AccountID())
+}
+
+func TestParseResourceWithColons(t *testing.T) {
+	s := "crn:v1:bluemix:public:kms:us-south:a/abc:instance-id:key:path:to:key"
+	c, err := Parse(s)
+	assert.Nil(t, err)
+	assert.Equal(t, "key", c.ResourceType)
+	assert.Equal(t, "path:to:key", c.Resource)
+	assert.Equal(t, s, c.String())
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"crn:v1:bluemix:public",
+		"urn:v1:bluemix:public:kms:us-south:a/abc:instance-id::",
+		"crn::bluemix:public:kms:us-south:a/abc:instance-id::",
+		"crn:v1::public:kms:us-south:a/abc:instance-id::",
+		"crn:v1:bluemix::kms:us-south:a/abc:instance-id::",
+		"crn:v1:bluemix:public:kms:us south:a/abc:instance-id::",
+		"crn:v1:bluemix:public:kms:us-south:abc:instance-id::",
+		"crn:v1:bluemix:public:kms:us-south:a/:instance-id::",
+	} {
+		_, err := Parse(s)
+		assert.NotNil(t, err, s)
+		assert.False(t, IsValid(s), s)
+	}
+
+	_, err := ParsePtr(nil)
+	assert.NotNil(t, err)
+	assert.Panics(t, func() { MustParse("crn:v1") })
+}
+
+func TestBuild(t *testing.T) {
+	c := CRN{
+		Version:     Version1,
+		CName:       "bluemix",
+		CType:       "public",
+		ServiceName: "iam-groups",
+		Location:    "global",
+	}.WithAccountScope("abc")
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, "crn:v1:bluemix:public:iam-groups:global:a/abc:::", c.String())
+
+	c.Resource = "a:b"
+	assert.Equal(t, "crn:v1:bluemix:public:iam-groups:global:a/abc:::a:b", c.String())
+	c.Location = "us:south"
+	assert.NotNil(t, c.Validate())
+
+	assert.Equal(t, "", CRN{}.String())
+	assert.True(t, CRN{}.IsZero())
+	assert.Equal(t, "", CRN{Scope: "o/abc"}.AccountID())
+}
+
+func TestMatches(t *testing.T) {
+	c := MustParse(instanceCRN)
+	assert.True(t, c.MatchString("crn:v1:bluemix:public:cloud-object-storage:::::"))
+	assert.True(t, c.MatchString("crn:v1:bluemix:public:cloud-*:*:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:::"))
+	assert.True(t, c.MatchString("crn:::::::::"))
+	assert.False(t, c.MatchString("crn:v1:bluemix:public:kms:::::"))
+	assert.False(t, c.MatchString("crn:v1:bluemix:public::us-south:::::"))
+	assert.False(t, c.MatchString("crn:v1:bluemix:public:cloud-object-storage::a/other:::"))
+	assert.False(t, c.MatchString("crn:v1"))
+	assert.True(t, c.Matches(CRN{ServiceName: "cloud-object-storage"}))
+
+	// "*" matches the "/" of a scope or resource.
+	assert.True(t, c.MatchString("crn:v1:bluemix:public:cloud-object-storage:*:*:*:*:*"))
+	assert.True(t, c.MatchString("crn:v1:bluemix:public:cloud-object-storage:global:*/59bcbfa6ea2f006b4ed7094c1a08dcdd:::"))
+	object := MustParse("crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4:object:my-bucket/reports/2025/q1.csv")
+	assert.True(t, object.MatchString("crn:v1:bluemix:public:cloud-object-storage:*:*:*:object:my-bucket/*"))
+	assert.True(t, object.MatchString("crn:v1:bluemix:public:cloud-object-storage:*:*:*:object:*.csv"))
+	assert.False(t, object.MatchString("crn:v1:bluemix:public:cloud-object-storage:*:*:*:object:other-bucket/*"))
+}
+
+func TestJSON(t *testing.T) {
+	type resource struct {
+		CRN       CRN  `json:"crn"`
+		ParentCRN *CRN `json:"parent_crn,omitempty"`
+	}
+
+	var r resource
+	err := json.Unmarshal([]byte(`{"crn":"`+instanceCRN+`"}`), &r)
+	assert.Nil(t, err)
+	assert.Equal(t, "cloud-object-storage", r.CRN.ServiceName)
+	assert.Nil(t, r.ParentCRN)
+
+	b, err := json.Marshal(r)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"crn":"`+instanceCRN+`"}`, string(b))
+
+	err = json.Unmarshal([]byte(`{"crn":"not-a-crn"}`), &r)
+	assert.NotNil(t, err)
+
+	err = json.Unmarshal([]byte(`{"crn":""}`), &r)
+	assert.Nil(t, err)
+	assert.True(t, r.CRN.IsZero())
+}
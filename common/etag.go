@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultReadModifyWriteAttempts is the number of attempts made by ReadModifyWrite
+// when ReadModifyWriteOptions.MaxAttempts is not set.
+const DefaultReadModifyWriteAttempts = 3
+
+// ReadModifyWriteOptions : The ReadModifyWrite options.
+type ReadModifyWriteOptions struct {
+	// The maximum number of read-modify-write cycles, including the first one.
+	// Defaults to DefaultReadModifyWriteAttempts.
+	MaxAttempts int
+}
+
+// GetETag returns the value of the ETag header of "response", or an empty string if there is none.
+func GetETag(response *core.DetailedResponse) string {
+	if response == nil {
+		return ""
+	}
+	return response.GetHeaders().Get("ETag")
+}
+
+// IsPreconditionFailed returns true if "response" or "err" indicates that a request failed
+// with a 412 Precondition Failed status, because its If-Match value did not match the current
+// revision of the resource.
+func IsPreconditionFailed(response *core.DetailedResponse, err error) bool {
+	if response != nil && response.GetStatusCode() == http.StatusPreconditionFailed {
+		return true
+	}
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) && httpProblem.Response != nil {
+		return httpProblem.Response.GetStatusCode() == http.StatusPreconditionFailed
+	}
+	return false
+}
+
+// ReadModifyWrite updates a resource using optimistic concurrency control.
+//
+// It calls "read" to retrieve the current state of the resource, then calls "write" with that
+// state and the value of the ETag header of the read response. "write" is expected to apply the
+// desired changes and send the update with the ETag as its If-Match value. If the update fails
+// with a 412 Precondition Failed status because the resource was modified in the meantime,
+// the cycle is repeated, up to MaxAttempts times.
+//
+// For example, to update the description of an access group:
+//
+//	group, _, err := common.ReadModifyWrite(ctx,
+//		func(ctx context.Context) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+//			return service.GetAccessGroupWithContext(ctx, service.NewGetAccessGroupOptions(groupID))
+//		},
+//		func(ctx context.Context, current *iamaccessgroupsv2.Group, etag string) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+//			options := service.NewUpdateAccessGroupOptions(*current.ID, etag)
+//			options.SetDescription(*current.Description + " (managed)")
+//			return service.UpdateAccessGroupWithContext(ctx, options)
+//		}, nil)
+//
+// The result and response of the last write are returned. An error is returned if a read fails,
+// if a read response has no ETag header, if a write fails for another reason, or if the
+// attempts are exhausted; in the latter case the error is that of the last write.
+func ReadModifyWrite[T any, R any](ctx context.Context,
+	read func(ctx context.Context) (T, *core.DetailedResponse, error),
+	write func(ctx context.Context, current T, etag string) (R, *core.DetailedResponse, error),
+	opts *ReadModifyWriteOptions) (result R, response *core.DetailedResponse, err error) {
+	maxAttempts := DefaultReadModifyWriteAttempts
+	if opts != nil && opts.MaxAttempts > 0 {
+		maxAttempts = opts.MaxAttempts
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = ctx.Err(); err != nil {
+			err = core.SDKErrorf(err, "", "context-done", GetComponentInfo())
+			return
+		}
+
+		var current T
+		current, response, err = read(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "read-error")
+			return
+		}
+		etag := GetETag(response)
+		if etag == "" {
+			err = core.SDKErrorf(nil, "the read response has no ETag header", "missing-etag", GetComponentInfo())
+			return
+		}
+
+		result, response, err = write(ctx, current, etag)
+		if err == nil || !IsPreconditionFailed(response, err) {
+			return
+		}
+	}
+	err = core.SDKErrorf(err, fmt.Sprintf("the resource was modified concurrently during %d attempts", maxAttempts), "precondition-failed", GetComponentInfo())
+	return
+}
@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// testResource is a resource whose revision is modified concurrently "conflicts" times.
+type testResource struct {
+	value     string
+	revision  int
+	conflicts int
+	reads     int
+	writes    int
+}
+
+func (r *testResource) read(ctx context.Context) (string, *core.DetailedResponse, error) {
+	r.reads++
+	headers := http.Header{}
+	headers.Set("ETag", strconv.Itoa(r.revision))
+	return r.value, &core.DetailedResponse{StatusCode: http.StatusOK, Headers: headers}, nil
+}
+
+func (r *testResource) write(ctx context.Context, current string, etag string) (string, *core.DetailedResponse, error) {
+	r.writes++
+	if r.conflicts > 0 {
+		r.conflicts--
+		r.revision++
+	}
+	if etag != strconv.Itoa(r.revision) {
+		return "", &core.DetailedResponse{StatusCode: http.StatusPreconditionFailed}, errors.New("precondition failed")
+	}
+	r.value = current + "!"
+	r.revision++
+	return r.value, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
+
+func TestReadModifyWrite(t *testing.T) {
+	r := &testResource{value: "a"}
+	result, response, err := ReadModifyWrite(context.Background(), r.read, r.write, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "a!", result)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, r.reads)
+}
+
+func TestReadModifyWriteRetry(t *testing.T) {
+	r := &testResource{value: "a", conflicts: 2}
+	result, _, err := ReadModifyWrite(context.Background(), r.read, r.write, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "a!", result)
+	assert.Equal(t, 3, r.reads)
+	assert.Equal(t, 3, r.writes)
+
+	r = &testResource{value: "a", conflicts: 2}
+	_, response, err := ReadModifyWrite(context.Background(), r.read, r.write, &ReadModifyWriteOptions{MaxAttempts: 2})
+	assert.NotNil(t, err)
+	assert.True(t, IsPreconditionFailed(response, err))
+	assert.Equal(t, 2, r.writes)
+	assert.Equal(t, "a", r.value)
+}
+
+func TestReadModifyWriteErrors(t *testing.T) {
+	r := &testResource{value: "a"}
+	_, _, err := ReadModifyWrite(context.Background(),
+		func(ctx context.Context) (string, *core.DetailedResponse, error) {
+			return "", &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+		}, r.write, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, r.writes)
+
+	_, _, err = ReadModifyWrite(context.Background(), r.read,
+		func(ctx context.Context, current string, etag string) (string, *core.DetailedResponse, error) {
+			return "", &core.DetailedResponse{StatusCode: http.StatusBadRequest}, errors.New("bad request")
+		}, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, r.reads)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = ReadModifyWrite(ctx, r.read, r.write, nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestIsPreconditionFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusPreconditionFailed)
+		fmt.Fprint(res, `{"errors":[{"code":"precondition_failed","message":"ETag mismatch"}]}`)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	builder := core.NewRequestBuilder(core.PUT)
+	_, err = builder.ResolveRequestURL(server.URL, "/resource", nil)
+	assert.Nil(t, err)
+	request, err := builder.Build()
+	assert.Nil(t, err)
+	_, err = service.Request(request, nil)
+	assert.NotNil(t, err)
+
+	// The error of a generated method wraps the HTTP problem.
+	err = core.SDKErrorf(err, "", "http-request-err", GetComponentInfo())
+	assert.True(t, IsPreconditionFailed(nil, err))
+	assert.False(t, IsPreconditionFailed(nil, errors.New("other")))
+	assert.False(t, IsPreconditionFailed(&core.DetailedResponse{StatusCode: http.StatusOK}, nil))
+	assert.Equal(t, "", GetETag(nil))
+}
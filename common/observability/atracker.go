@@ -0,0 +1,318 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+)
+
+// atrackerAllLocations is the location of an Activity Tracker rule that matches events of all locations.
+const atrackerAllLocations = "*"
+
+// NewAtrackerRouter returns a Router over an Activity Tracker client.
+func NewAtrackerRouter(atracker *atrackerv2.AtrackerV2) Router {
+	return &atrackerRouter{client: atracker}
+}
+
+type atrackerRouter struct {
+	client *atrackerv2.AtrackerV2
+}
+
+func (router *atrackerRouter) Service() string {
+	return ServiceAtracker
+}
+
+func (router *atrackerRouter) ListTargets(ctx context.Context) ([]Target, error) {
+	result, _, err := router.client.ListTargetsWithContext(ctx, router.client.NewListTargetsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("error listing the targets of %s: %w", ServiceAtracker, err)
+	}
+	targets := make([]Target, len(result.Targets))
+	for i := range result.Targets {
+		targets[i] = &atrackerTarget{&result.Targets[i]}
+	}
+	return targets, nil
+}
+
+func (router *atrackerRouter) ListRoutes(ctx context.Context) ([]Route, error) {
+	result, _, err := router.client.ListRoutesWithContext(ctx, router.client.NewListRoutesOptions())
+	if err != nil {
+		return nil, fmt.Errorf("error listing the routes of %s: %w", ServiceAtracker, err)
+	}
+	routes := make([]Route, len(result.Routes))
+	for i := range result.Routes {
+		routes[i] = &atrackerRoute{&result.Routes[i]}
+	}
+	return routes, nil
+}
+
+func (router *atrackerRouter) GetSettings(ctx context.Context) (Settings, error) {
+	result, _, err := router.client.GetSettingsWithContext(ctx, router.client.NewGetSettingsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("error getting the settings of %s: %w", ServiceAtracker, err)
+	}
+	return &atrackerSettings{result}, nil
+}
+
+func (router *atrackerRouter) CreateTarget(ctx context.Context, spec *TargetSpec) (Target, error) {
+	options := router.client.NewCreateTargetOptions(spec.Name, spec.TargetType)
+	switch spec.TargetType {
+	case TargetTypeCloudLogs:
+		options.SetCloudlogsEndpoint(&atrackerv2.CloudLogsEndpointPrototype{TargetCRN: core.StringPtr(spec.DestinationCRN)})
+	case TargetTypeCloudObjectStorage:
+		if spec.COS == nil {
+			return nil, fmt.Errorf("the target '%s' of type %s has no Cloud Object Storage endpoint", spec.Name, spec.TargetType)
+		}
+		endpoint := &atrackerv2.CosEndpointPrototype{
+			Endpoint:                core.StringPtr(spec.COS.Endpoint),
+			TargetCRN:               core.StringPtr(spec.DestinationCRN),
+			Bucket:                  core.StringPtr(spec.COS.Bucket),
+			ServiceToServiceEnabled: core.BoolPtr(spec.COS.ServiceToServiceEnabled),
+		}
+		if spec.COS.APIKey != "" {
+			endpoint.APIKey = core.StringPtr(spec.COS.APIKey)
+		}
+		options.SetCosEndpoint(endpoint)
+	case TargetTypeEventStreams:
+		if spec.EventStreams == nil {
+			return nil, fmt.Errorf("the target '%s' of type %s has no Event Streams endpoint", spec.Name, spec.TargetType)
+		}
+		endpoint := &atrackerv2.EventstreamsEndpointPrototype{
+			TargetCRN:               core.StringPtr(spec.DestinationCRN),
+			Brokers:                 spec.EventStreams.Brokers,
+			Topic:                   core.StringPtr(spec.EventStreams.Topic),
+			ServiceToServiceEnabled: core.BoolPtr(spec.EventStreams.ServiceToServiceEnabled),
+		}
+		if spec.EventStreams.APIKey != "" {
+			endpoint.APIKey = core.StringPtr(spec.EventStreams.APIKey)
+		}
+		options.SetEventstreamsEndpoint(endpoint)
+	default:
+		return nil, fmt.Errorf("%s does not support targets of type '%s'", ServiceAtracker, spec.TargetType)
+	}
+	if spec.Region != "" {
+		options.SetRegion(spec.Region)
+	}
+	if spec.ManagedBy != "" {
+		options.SetManagedBy(spec.ManagedBy)
+	}
+	result, _, err := router.client.CreateTargetWithContext(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the target '%s' of %s: %w", spec.Name, ServiceAtracker, err)
+	}
+	return &atrackerTarget{result}, nil
+}
+
+func (router *atrackerRouter) CreateRoute(ctx context.Context, spec *RouteSpec) (Route, error) {
+	rules := make([]atrackerv2.RulePrototype, len(spec.Rules))
+	for i, r := range spec.Rules {
+		if r.Action != "" && r.Action != routing.ActionSend {
+			return nil, fmt.Errorf("%s does not support the action '%s' of rule %d of the route '%s'", ServiceAtracker, r.Action, i, spec.Name)
+		}
+		rules[i] = atrackerv2.RulePrototype{TargetIds: r.TargetIDs, Locations: []string{atrackerAllLocations}}
+		switch {
+		case len(r.InclusionFilters) == 0:
+		case len(r.InclusionFilters) == 1 && r.InclusionFilters[0].Operand == routing.OperandLocation &&
+			(r.InclusionFilters[0].Operator == routing.OperatorIn || r.InclusionFilters[0].Operator == routing.OperatorIs):
+			rules[i].Locations = r.InclusionFilters[0].Values
+		default:
+			return nil, fmt.Errorf("%s only supports a single location filter, in rule %d of the route '%s'", ServiceAtracker, i, spec.Name)
+		}
+	}
+	options := router.client.NewCreateRouteOptions(spec.Name, rules)
+	if spec.ManagedBy != "" {
+		options.SetManagedBy(spec.ManagedBy)
+	}
+	result, _, err := router.client.CreateRouteWithContext(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the route '%s' of %s: %w", spec.Name, ServiceAtracker, err)
+	}
+	return &atrackerRoute{result}, nil
+}
+
+func (router *atrackerRouter) UpdateSettings(ctx context.Context, spec *SettingsSpec) (Settings, error) {
+	options := router.client.NewPutSettingsOptions(spec.PrimaryMetadataRegion, spec.PrivateAPIEndpointOnly)
+	options.DefaultTargets = spec.DefaultTargetIDs
+	options.PermittedTargetRegions = spec.PermittedTargetRegions
+	if spec.BackupMetadataRegion != "" {
+		options.SetMetadataRegionBackup(spec.BackupMetadataRegion)
+	}
+	result, _, err := router.client.PutSettingsWithContext(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("error updating the settings of %s: %w", ServiceAtracker, err)
+	}
+	return &atrackerSettings{result}, nil
+}
+
+type atrackerTarget struct {
+	model *atrackerv2.Target
+}
+
+func (target *atrackerTarget) Service() string {
+	return ServiceAtracker
+}
+
+func (target *atrackerTarget) ID() string {
+	return core.StringNilMapper(target.model.ID)
+}
+
+func (target *atrackerTarget) Name() string {
+	return core.StringNilMapper(target.model.Name)
+}
+
+func (target *atrackerTarget) CRN() string {
+	return core.StringNilMapper(target.model.CRN)
+}
+
+func (target *atrackerTarget) TargetType() string {
+	return core.StringNilMapper(target.model.TargetType)
+}
+
+func (target *atrackerTarget) Region() string {
+	return core.StringNilMapper(target.model.Region)
+}
+
+func (target *atrackerTarget) ManagedBy() string {
+	return core.StringNilMapper(target.model.ManagedBy)
+}
+
+func (target *atrackerTarget) Model() interface{} {
+	return target.model
+}
+
+func (target *atrackerTarget) DestinationCRN() string {
+	switch {
+	case target.model.CloudlogsEndpoint != nil:
+		return core.StringNilMapper(target.model.CloudlogsEndpoint.TargetCRN)
+	case target.model.CosEndpoint != nil:
+		return core.StringNilMapper(target.model.CosEndpoint.TargetCRN)
+	case target.model.EventstreamsEndpoint != nil:
+		return core.StringNilMapper(target.model.EventstreamsEndpoint.TargetCRN)
+	}
+	return ""
+}
+
+func (target *atrackerTarget) WriteStatus() (string, string) {
+	if target.model.WriteStatus == nil {
+		return "", ""
+	}
+	return core.StringNilMapper(target.model.WriteStatus.Status), core.StringNilMapper(target.model.WriteStatus.ReasonForLastFailure)
+}
+
+// spec returns the definition of the target, without the API keys of its endpoint.
+func (target *atrackerTarget) spec() *TargetSpec {
+	spec := &TargetSpec{
+		Name:           target.Name(),
+		TargetType:     target.TargetType(),
+		DestinationCRN: target.DestinationCRN(),
+		Region:         target.Region(),
+		ManagedBy:      target.ManagedBy(),
+	}
+	if endpoint := target.model.CosEndpoint; endpoint != nil {
+		spec.COS = &COSEndpointSpec{
+			Endpoint:                core.StringNilMapper(endpoint.Endpoint),
+			Bucket:                  core.StringNilMapper(endpoint.Bucket),
+			ServiceToServiceEnabled: endpoint.ServiceToServiceEnabled != nil && *endpoint.ServiceToServiceEnabled,
+		}
+	}
+	if endpoint := target.model.EventstreamsEndpoint; endpoint != nil {
+		spec.EventStreams = &EventStreamsEndpointSpec{
+			Brokers:                 endpoint.Brokers,
+			Topic:                   core.StringNilMapper(endpoint.Topic),
+			ServiceToServiceEnabled: endpoint.ServiceToServiceEnabled != nil && *endpoint.ServiceToServiceEnabled,
+		}
+	}
+	return spec
+}
+
+type atrackerRoute struct {
+	model *atrackerv2.Route
+}
+
+func (route *atrackerRoute) Service() string {
+	return ServiceAtracker
+}
+
+func (route *atrackerRoute) ID() string {
+	return core.StringNilMapper(route.model.ID)
+}
+
+func (route *atrackerRoute) Name() string {
+	return core.StringNilMapper(route.model.Name)
+}
+
+func (route *atrackerRoute) CRN() string {
+	return core.StringNilMapper(route.model.CRN)
+}
+
+func (route *atrackerRoute) ManagedBy() string {
+	return core.StringNilMapper(route.model.ManagedBy)
+}
+
+func (route *atrackerRoute) Model() interface{} {
+	return route.model
+}
+
+func (route *atrackerRoute) Rules() []Rule {
+	rules := make([]Rule, len(route.model.Rules))
+	for i, r := range route.model.Rules {
+		converted := &rule{action: routing.ActionSend, targetIDs: r.TargetIds}
+		if len(r.Locations) > 0 && !slices.Contains(r.Locations, atrackerAllLocations) {
+			converted.inclusionFilters = []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIn, Values: r.Locations}}
+		}
+		rules[i] = converted
+	}
+	return rules
+}
+
+type atrackerSettings struct {
+	model *atrackerv2.Settings
+}
+
+func (settings *atrackerSettings) Service() string {
+	return ServiceAtracker
+}
+
+func (settings *atrackerSettings) DefaultTargetIDs() []string {
+	return settings.model.DefaultTargets
+}
+
+func (settings *atrackerSettings) PermittedTargetRegions() []string {
+	return settings.model.PermittedTargetRegions
+}
+
+func (settings *atrackerSettings) PrimaryMetadataRegion() string {
+	return core.StringNilMapper(settings.model.MetadataRegionPrimary)
+}
+
+func (settings *atrackerSettings) BackupMetadataRegion() string {
+	return core.StringNilMapper(settings.model.MetadataRegionBackup)
+}
+
+func (settings *atrackerSettings) PrivateAPIEndpointOnly() bool {
+	return settings.model.PrivateAPIEndpointOnly != nil && *settings.model.PrivateAPIEndpointOnly
+}
+
+func (settings *atrackerSettings) Model() interface{} {
+	return settings.model
+}
@@ -0,0 +1,577 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"sigs.k8s.io/yaml"
+)
+
+// BundleVersion is the version of the bundles written by Export. ReadBundle rejects the bundles of other versions.
+const BundleVersion = 1
+
+// The formats of a bundle supported by Bundle.Write.
+const (
+	BundleFormatJSON = "json"
+	BundleFormatYAML = "yaml"
+)
+
+// Bundle : The routing configuration of an account in a portable form. The fields generated by the services (IDs,
+// CRNs, timestamps and write statuses) are omitted, and the targets are referenced by name, so that a bundle can be
+// imported in another account or region.
+type Bundle struct {
+	Version  int             `json:"version"`
+	Services []ServiceBundle `json:"services"`
+}
+
+// ServiceBundle : The routing configuration of a service in a Bundle.
+type ServiceBundle struct {
+	// The service, such as ServiceLogsRouter.
+	Service string `json:"service"`
+
+	// The targets. The API keys of their endpoints are not exported and must be added before an import, unless
+	// service to service authentication is enabled.
+	Targets []TargetSpec `json:"targets,omitempty"`
+
+	Routes   []BundleRoute   `json:"routes,omitempty"`
+	Settings *BundleSettings `json:"settings,omitempty"`
+}
+
+// BundleRoute : A route in a Bundle.
+type BundleRoute struct {
+	Name      string       `json:"name"`
+	Rules     []BundleRule `json:"rules"`
+	ManagedBy string       `json:"managed_by,omitempty"`
+}
+
+// BundleRule : A rule of a route in a Bundle.
+type BundleRule struct {
+	Action string `json:"action,omitempty"`
+
+	// The names of the targets of the rule.
+	Targets []string `json:"targets"`
+
+	InclusionFilters []routing.InclusionFilter `json:"inclusion_filters,omitempty"`
+}
+
+// BundleSettings : The settings of a service in a Bundle.
+type BundleSettings struct {
+	// The names of the default targets.
+	DefaultTargets []string `json:"default_targets,omitempty"`
+
+	PermittedTargetRegions []string `json:"permitted_target_regions,omitempty"`
+	PrimaryMetadataRegion  string   `json:"primary_metadata_region,omitempty"`
+	BackupMetadataRegion   string   `json:"backup_metadata_region,omitempty"`
+
+	// Whether the API is only reachable through private endpoints. If nil, Import keeps the current value.
+	PrivateAPIEndpointOnly *bool `json:"private_api_endpoint_only,omitempty"`
+}
+
+// Service returns the routing configuration of "service" in the bundle, or nil if the bundle has none.
+func (bundle *Bundle) Service(service string) *ServiceBundle {
+	for i := range bundle.Services {
+		if bundle.Services[i].Service == service {
+			return &bundle.Services[i]
+		}
+	}
+	return nil
+}
+
+// Export retrieves the routing configuration of each of "routers" and returns it as a Bundle.
+func Export(ctx context.Context, routers ...Router) (*Bundle, error) {
+	inventory, err := Collect(ctx, routers...)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.Bundle()
+}
+
+// Bundle returns the routing configuration of the inventory as a Bundle. An error is returned if two targets of a
+// service have the same name, or if a route or the settings reference a target that does not exist, since such a
+// configuration cannot be expressed with target names.
+func (inventory *Inventory) Bundle() (*Bundle, error) {
+	bundle := &Bundle{Version: BundleVersion}
+	for _, service := range inventory.Services {
+		serviceBundle, err := service.bundle(true)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Services = append(bundle.Services, *serviceBundle)
+	}
+	return bundle, nil
+}
+
+// bundle returns the routing configuration of the service as a ServiceBundle. If "strict" is false, the references
+// to targets that do not exist are kept as target IDs instead of returning an error.
+func (inventory *ServiceInventory) bundle(strict bool) (*ServiceBundle, error) {
+	result := &ServiceBundle{Service: inventory.Service}
+	names := make(map[string]string)
+	seen := make(map[string]bool)
+	for _, target := range inventory.Targets {
+		if seen[target.Name()] {
+			return nil, fmt.Errorf("%s: more than one target is named '%s'", inventory.Service, target.Name())
+		}
+		seen[target.Name()] = true
+		names[target.ID()] = target.Name()
+		result.Targets = append(result.Targets, *targetSpec(target))
+	}
+	targetNames := func(ids []string, resource string) ([]string, error) {
+		var result []string
+		for _, id := range ids {
+			name, found := names[id]
+			if !found {
+				if strict {
+					return nil, fmt.Errorf("%s: %s references the target '%s', which does not exist", inventory.Service, resource, id)
+				}
+				name = id
+			}
+			result = append(result, name)
+		}
+		return result, nil
+	}
+
+	for _, route := range inventory.Routes {
+		bundleRoute := BundleRoute{Name: route.Name(), ManagedBy: route.ManagedBy()}
+		for _, r := range route.Rules() {
+			targets, err := targetNames(r.TargetIDs(), fmt.Sprintf("the route '%s'", route.Name()))
+			if err != nil {
+				return nil, err
+			}
+			bundleRoute.Rules = append(bundleRoute.Rules, BundleRule{Action: r.Action(), Targets: targets, InclusionFilters: r.InclusionFilters()})
+		}
+		result.Routes = append(result.Routes, bundleRoute)
+	}
+	if inventory.Settings != nil {
+		defaultTargets, err := targetNames(inventory.Settings.DefaultTargetIDs(), "the settings")
+		if err != nil {
+			return nil, err
+		}
+		// Omitted rather than empty, as in a bundle read from a file.
+		permittedRegions := inventory.Settings.PermittedTargetRegions()
+		if len(permittedRegions) == 0 {
+			permittedRegions = nil
+		}
+		result.Settings = &BundleSettings{
+			DefaultTargets:         defaultTargets,
+			PermittedTargetRegions: permittedRegions,
+			PrimaryMetadataRegion:  inventory.Settings.PrimaryMetadataRegion(),
+			BackupMetadataRegion:   inventory.Settings.BackupMetadataRegion(),
+			PrivateAPIEndpointOnly: core.BoolPtr(inventory.Settings.PrivateAPIEndpointOnly()),
+		}
+	}
+	return result, nil
+}
+
+// targetSpec returns the definition of "target", without the API keys of its endpoint.
+func targetSpec(target Target) *TargetSpec {
+	if t, ok := target.(interface{ spec() *TargetSpec }); ok {
+		return t.spec()
+	}
+	return &TargetSpec{
+		Name:           target.Name(),
+		TargetType:     target.TargetType(),
+		DestinationCRN: target.DestinationCRN(),
+		Region:         target.Region(),
+		ManagedBy:      target.ManagedBy(),
+	}
+}
+
+// Write writes the bundle to "w" in "format": BundleFormatJSON or BundleFormatYAML.
+func (bundle *Bundle) Write(w io.Writer, format string) error {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	switch format {
+	case BundleFormatJSON:
+		data = append(data, '\n')
+	case BundleFormatYAML:
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported bundle format '%s'", format)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ReadBundle reads a bundle in the JSON or YAML format from "r". An error is returned if the bundle has unknown
+// fields or a version other than BundleVersion.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is a subset of YAML.
+	if data, err = yaml.YAMLToJSON(data); err != nil {
+		return nil, fmt.Errorf("error parsing the bundle: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	bundle := &Bundle{}
+	if err = decoder.Decode(bundle); err != nil {
+		return nil, fmt.Errorf("error parsing the bundle: %w", err)
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d, expected %d", bundle.Version, BundleVersion)
+	}
+	return bundle, nil
+}
+
+// The kinds of resources of a Change.
+const (
+	ChangeKindTarget   = "target"
+	ChangeKindRoute    = "route"
+	ChangeKindSettings = "settings"
+)
+
+// The actions of a Change.
+const (
+	// The resource does not exist and is created.
+	ChangeCreate = "create"
+
+	// The settings differ from the bundle and are updated.
+	ChangeUpdate = "update"
+
+	// A resource with the same name exists, with the definition of the bundle.
+	ChangeUnchanged = "unchanged"
+
+	// A resource with the same name exists, with a definition that differs from the bundle. It is not modified,
+	// but its ID is used for the references to it.
+	ChangeConflict = "conflict"
+)
+
+// Change : The import of a resource of a Bundle.
+type Change struct {
+	Service string
+
+	// The kind of resource, such as ChangeKindTarget.
+	Kind string
+
+	// The name of the target or route, or the empty string for the settings.
+	Name string
+
+	// The action, such as ChangeCreate.
+	Action string
+
+	// The ID of the target or route in the destination account: the existing one, or the created one. Empty if
+	// the resource is not created because of a dry run.
+	ID string
+
+	// The differences between the existing resource and the bundle, in the form "field: existing -> bundle".
+	Differences []string
+}
+
+var changeSymbols = map[string]string{
+	ChangeCreate:    "+",
+	ChangeUpdate:    "~",
+	ChangeUnchanged: "=",
+	ChangeConflict:  "!",
+}
+
+// String returns a one line description of the change.
+func (change Change) String() string {
+	description := fmt.Sprintf("%s %s %s", changeSymbols[change.Action], change.Service, change.Kind)
+	if change.Name != "" {
+		description += fmt.Sprintf(" '%s'", change.Name)
+	}
+	if change.Action == ChangeConflict {
+		description += " (exists with a different definition, left unchanged)"
+	}
+	return description
+}
+
+// ImportOptions : The Import options.
+type ImportOptions struct {
+	// True to compute the changes without making them.
+	DryRun bool
+
+	// True to leave the settings unchanged.
+	SkipSettings bool
+}
+
+// ImportResult : The changes made, or to make in a dry run, by Import.
+type ImportResult struct {
+	DryRun  bool
+	Changes []Change
+}
+
+// WriteDiff writes the changes to "w", one per line, each followed by its differences.
+func (result *ImportResult) WriteDiff(w io.Writer) error {
+	for _, change := range result.Changes {
+		if _, err := fmt.Fprintln(w, change); err != nil {
+			return err
+		}
+		for _, difference := range change.Differences {
+			if _, err := fmt.Fprintf(w, "    %s\n", difference); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Import recreates the routing configuration of "bundle" with "routers", which must include a router for each
+// service of the bundle. For each service, the targets are created first, then the routes, whose rules reference
+// the created targets by their new IDs, and finally the settings are updated if they differ from the bundle.
+//
+// The targets and routes that already exist with the same name are not modified: the existing targets are
+// referenced by the created routes, and the differences with the bundle are reported with ChangeConflict. The
+// API keys of the COS and Event Streams endpoints are not compared. The default targets of the settings are not
+// removed if the bundle has none.
+//
+// If an error occurs, the changes made so far are returned along with the error.
+func Import(ctx context.Context, bundle *Bundle, routers []Router, opts *ImportOptions) (*ImportResult, error) {
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d, expected %d", bundle.Version, BundleVersion)
+	}
+	result := &ImportResult{DryRun: opts.DryRun}
+	for i := range bundle.Services {
+		service := &bundle.Services[i]
+		index := slices.IndexFunc(routers, func(router Router) bool { return router.Service() == service.Service })
+		if index < 0 {
+			return result, fmt.Errorf("no router for the service '%s' of the bundle", service.Service)
+		}
+		if err := importService(ctx, service, routers[index], opts, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// importService imports the routing configuration of a service with "router", and appends the changes to "result".
+func importService(ctx context.Context, service *ServiceBundle, router Router, opts *ImportOptions, result *ImportResult) error {
+	inventory, err := Collect(ctx, router)
+	if err != nil {
+		return err
+	}
+	current, err := inventory.Services[0].bundle(false)
+	if err != nil {
+		return err
+	}
+	record := func(kind string, name string, action string, id string, differences []string) {
+		result.Changes = append(result.Changes, Change{Service: service.Service, Kind: kind, Name: name, Action: action, ID: id, Differences: differences})
+	}
+
+	// The IDs of the targets in the destination account, and the targets to create, by name.
+	ids := make(map[string]string)
+	for _, target := range inventory.Services[0].Targets {
+		ids[target.Name()] = target.ID()
+	}
+	planned := make(map[string]bool)
+	resolve := func(names []string, resource string) ([]string, error) {
+		var result []string
+		for _, name := range names {
+			if id, found := ids[name]; found {
+				result = append(result, id)
+			} else if !planned[name] {
+				return nil, fmt.Errorf("%s: %s references the target '%s', which is neither in the bundle nor in the account", service.Service, resource, name)
+			}
+		}
+		return result, nil
+	}
+
+	for i := range service.Targets {
+		spec := &service.Targets[i]
+		if index := slices.IndexFunc(current.Targets, func(target TargetSpec) bool { return target.Name == spec.Name }); index >= 0 {
+			recordExisting(record, ChangeKindTarget, spec.Name, ids[spec.Name], withoutAPIKeys(&current.Targets[index]), withoutAPIKeys(spec))
+			continue
+		}
+		if opts.DryRun {
+			planned[spec.Name] = true
+			record(ChangeKindTarget, spec.Name, ChangeCreate, "", nil)
+			continue
+		}
+		target, err := router.CreateTarget(ctx, spec)
+		if err != nil {
+			return err
+		}
+		ids[spec.Name] = target.ID()
+		record(ChangeKindTarget, spec.Name, ChangeCreate, target.ID(), nil)
+	}
+
+	for i := range service.Routes {
+		bundleRoute := &service.Routes[i]
+		spec := &RouteSpec{Name: bundleRoute.Name, ManagedBy: bundleRoute.ManagedBy}
+		for _, r := range bundleRoute.Rules {
+			targetIDs, err := resolve(r.Targets, fmt.Sprintf("the route '%s'", bundleRoute.Name))
+			if err != nil {
+				return err
+			}
+			spec.Rules = append(spec.Rules, RuleSpec{Action: r.Action, TargetIDs: targetIDs, InclusionFilters: r.InclusionFilters})
+		}
+		if index := slices.IndexFunc(current.Routes, func(route BundleRoute) bool { return route.Name == bundleRoute.Name }); index >= 0 {
+			recordExisting(record, ChangeKindRoute, bundleRoute.Name, inventory.Services[0].Routes[index].ID(), &current.Routes[index], bundleRoute)
+			continue
+		}
+		if opts.DryRun {
+			record(ChangeKindRoute, bundleRoute.Name, ChangeCreate, "", nil)
+			continue
+		}
+		route, err := router.CreateRoute(ctx, spec)
+		if err != nil {
+			return err
+		}
+		record(ChangeKindRoute, bundleRoute.Name, ChangeCreate, route.ID(), nil)
+	}
+
+	if service.Settings == nil || opts.SkipSettings {
+		return nil
+	}
+	defaultTargetIDs, err := resolve(service.Settings.DefaultTargets, "the settings")
+	if err != nil {
+		return err
+	}
+	// The settings omitted by the bundle keep their current values: Activity Tracker replaces all the settings
+	// at once, so they are sent along with the others.
+	desired := *service.Settings
+	if current.Settings != nil {
+		if len(desired.DefaultTargets) == 0 {
+			desired.DefaultTargets = current.Settings.DefaultTargets
+			defaultTargetIDs = inventory.Services[0].Settings.DefaultTargetIDs()
+		}
+		if len(desired.PermittedTargetRegions) == 0 {
+			desired.PermittedTargetRegions = current.Settings.PermittedTargetRegions
+		}
+		if desired.PrimaryMetadataRegion == "" {
+			desired.PrimaryMetadataRegion = current.Settings.PrimaryMetadataRegion
+		}
+		if desired.BackupMetadataRegion == "" {
+			desired.BackupMetadataRegion = current.Settings.BackupMetadataRegion
+		}
+		if desired.PrivateAPIEndpointOnly == nil {
+			desired.PrivateAPIEndpointOnly = current.Settings.PrivateAPIEndpointOnly
+		}
+	}
+	var differences []string
+	if current.Settings != nil {
+		differences = diff(current.Settings, &desired)
+	} else {
+		differences = diff(&BundleSettings{}, &desired)
+	}
+	if len(differences) == 0 {
+		record(ChangeKindSettings, "", ChangeUnchanged, "", nil)
+		return nil
+	}
+	if !opts.DryRun {
+		_, err = router.UpdateSettings(ctx, &SettingsSpec{
+			DefaultTargetIDs:       defaultTargetIDs,
+			PermittedTargetRegions: desired.PermittedTargetRegions,
+			PrimaryMetadataRegion:  desired.PrimaryMetadataRegion,
+			BackupMetadataRegion:   desired.BackupMetadataRegion,
+			PrivateAPIEndpointOnly: desired.PrivateAPIEndpointOnly != nil && *desired.PrivateAPIEndpointOnly,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	record(ChangeKindSettings, "", ChangeUpdate, "", differences)
+	return nil
+}
+
+// recordExisting records a resource of the bundle that exists in the destination account.
+func recordExisting(record func(kind string, name string, action string, id string, differences []string), kind string, name string, id string, existing interface{}, desired interface{}) {
+	if differences := diff(existing, desired); len(differences) > 0 {
+		record(kind, name, ChangeConflict, id, differences)
+	} else {
+		record(kind, name, ChangeUnchanged, id, nil)
+	}
+}
+
+// withoutAPIKeys returns a copy of "spec" without the API keys of its endpoint.
+func withoutAPIKeys(spec *TargetSpec) *TargetSpec {
+	result := *spec
+	if result.COS != nil {
+		cos := *result.COS
+		cos.APIKey = ""
+		result.COS = &cos
+	}
+	if result.EventStreams != nil {
+		eventStreams := *result.EventStreams
+		eventStreams.APIKey = ""
+		result.EventStreams = &eventStreams
+	}
+	return &result
+}
+
+// diff returns the differences between the JSON representations of "existing" and "desired", in the form
+// "field: existing -> desired".
+func diff(existing interface{}, desired interface{}) (differences []string) {
+	diffValues("", toGeneric(existing), toGeneric(desired), &differences)
+	return
+}
+
+// diffValues appends the differences between "existing" and "desired", at "path", to "differences".
+func diffValues(path string, existing interface{}, desired interface{}, differences *[]string) {
+	existingMap, ok1 := existing.(map[string]interface{})
+	desiredMap, ok2 := desired.(map[string]interface{})
+	if ok1 && ok2 {
+		var keys []string
+		for key := range existingMap {
+			keys = append(keys, key)
+		}
+		for key := range desiredMap {
+			if _, found := existingMap[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffValues(strings.TrimPrefix(path+"."+key, "."), existingMap[key], desiredMap[key], differences)
+		}
+		return
+	}
+	existingSlice, ok1 := existing.([]interface{})
+	desiredSlice, ok2 := desired.([]interface{})
+	if ok1 && ok2 && len(existingSlice) == len(desiredSlice) {
+		for i := range existingSlice {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), existingSlice[i], desiredSlice[i], differences)
+		}
+		return
+	}
+	if !reflect.DeepEqual(existing, desired) {
+		*differences = append(*differences, fmt.Sprintf("%s: %s -> %s", path, toJSON(existing), toJSON(desired)))
+	}
+}
+
+// toGeneric returns the JSON representation of "value" as maps, slices and scalars.
+func toGeneric(value interface{}) (result interface{}) {
+	data, _ := json.Marshal(value)
+	_ = json.Unmarshal(data, &result)
+	return
+}
+
+// toJSON returns the JSON representation of "value".
+func toJSON(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
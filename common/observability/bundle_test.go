@@ -0,0 +1,224 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+
+	bundle, err := Export(context.Background(), routers[0], routers[2])
+	assert.Nil(t, err)
+	assert.Equal(t, &Bundle{
+		Version: BundleVersion,
+		Services: []ServiceBundle{
+			{
+				Service: ServiceAtracker,
+				Targets: []TargetSpec{
+					{
+						Name: "audit-bucket", TargetType: TargetTypeCloudObjectStorage, DestinationCRN: "crn:cos", Region: "us-south", ManagedBy: "account",
+						COS: &COSEndpointSpec{Endpoint: "s3.us.cloud-object-storage.appdomain.cloud", Bucket: "audit", ServiceToServiceEnabled: true},
+					},
+					{Name: "old-logs", TargetType: TargetTypeCloudLogs, DestinationCRN: "crn:logs-eu", Region: "eu-de"},
+				},
+				Routes: []BundleRoute{{Name: "all-events", Rules: []BundleRule{{Action: routing.ActionSend, Targets: []string{"audit-bucket"}}}}},
+				Settings: &BundleSettings{
+					DefaultTargets:         []string{"audit-bucket"},
+					PermittedTargetRegions: []string{"us-south", "us-east"},
+					PrimaryMetadataRegion:  "us-south",
+					PrivateAPIEndpointOnly: core.BoolPtr(true),
+				},
+			},
+			{
+				Service:  ServiceMetricsRouter,
+				Targets:  []TargetSpec{{Name: "monitoring", TargetType: TargetTypeSysdigMonitor, DestinationCRN: "crn:sysdig", Region: "us-south"}},
+				Settings: &BundleSettings{PrimaryMetadataRegion: "us-south", PrivateAPIEndpointOnly: core.BoolPtr(false)},
+			},
+		},
+	}, bundle)
+
+	for _, format := range []string{BundleFormatJSON, BundleFormatYAML} {
+		var buffer bytes.Buffer
+		assert.Nil(t, bundle.Write(&buffer, format))
+		read, err := ReadBundle(&buffer)
+		assert.Nil(t, err)
+		assert.Equal(t, bundle, read)
+	}
+	assert.NotNil(t, bundle.Write(&bytes.Buffer{}, "xml"))
+
+	// A route of the logs router references a target that does not exist.
+	_, err = Export(context.Background(), routers...)
+	assert.ErrorContains(t, err, "logs-router: the route 'regional' references the target 'lt9', which does not exist")
+}
+
+func TestReadBundle(t *testing.T) {
+	bundle, err := ReadBundle(strings.NewReader(`
+version: 1
+services:
+- service: logs-router
+  targets:
+  - name: platform-logs
+    target_type: cloud_logs
+    destination_crn: crn:logs
+  routes:
+  - name: regional
+    rules:
+    - targets: [platform-logs]
+      inclusion_filters:
+      - {operand: location, operator: in, values: [us-south]}
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []BundleRoute{{Name: "regional", Rules: []BundleRule{{
+		Targets:          []string{"platform-logs"},
+		InclusionFilters: []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIn, Values: []string{"us-south"}}},
+	}}}}, bundle.Service(ServiceLogsRouter).Routes)
+	assert.Nil(t, bundle.Service(ServiceAtracker))
+
+	_, err = ReadBundle(strings.NewReader(`{"version": 2, "services": []}`))
+	assert.ErrorContains(t, err, "unsupported bundle version 2")
+	_, err = ReadBundle(strings.NewReader(`{"version": 1, "services": [{"service": "atracker", "target": []}]}`))
+	assert.ErrorContains(t, err, `unknown field "target"`)
+}
+
+func TestImport(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+	atracker.responses["PUT /api/v2/settings"] = `{"default_targets": ["at3"], "metadata_region_primary": "eu-de"}`
+
+	bundle := &Bundle{
+		Version: BundleVersion,
+		Services: []ServiceBundle{{
+			Service: ServiceAtracker,
+			Targets: []TargetSpec{
+				{
+					Name: "audit-bucket", TargetType: TargetTypeCloudObjectStorage, DestinationCRN: "crn:cos", Region: "us-south", ManagedBy: "account",
+					COS: &COSEndpointSpec{Endpoint: "s3.us.cloud-object-storage.appdomain.cloud", Bucket: "audit", APIKey: "key", ServiceToServiceEnabled: true},
+				},
+				{Name: "new-logs", TargetType: TargetTypeCloudLogs, DestinationCRN: "crn:logs", Region: "eu-de"},
+			},
+			Routes: []BundleRoute{
+				{Name: "all-events", Rules: []BundleRule{{Action: routing.ActionSend, Targets: []string{"new-logs"}}}},
+				{Name: "regional", Rules: []BundleRule{{
+					Targets:          []string{"new-logs", "audit-bucket"},
+					InclusionFilters: []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIn, Values: []string{"eu-de"}}},
+				}}},
+			},
+			Settings: &BundleSettings{DefaultTargets: []string{"new-logs"}, PrimaryMetadataRegion: "eu-de"},
+		}},
+	}
+
+	result, err := Import(context.Background(), bundle, routers, &ImportOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.Empty(t, atracker.bodies)
+	var diff bytes.Buffer
+	assert.Nil(t, result.WriteDiff(&diff))
+	assert.Equal(t, `= atracker target 'audit-bucket'
++ atracker target 'new-logs'
+! atracker route 'all-events' (exists with a different definition, left unchanged)
+    rules[0].targets[0]: "audit-bucket" -> "new-logs"
++ atracker route 'regional'
+~ atracker settings
+    default_targets[0]: "audit-bucket" -> "new-logs"
+    primary_metadata_region: "us-south" -> "eu-de"
+`, diff.String())
+
+	result, err = Import(context.Background(), bundle, routers, nil)
+	assert.Nil(t, err)
+	assert.False(t, result.DryRun)
+	assert.Equal(t, []string{"at1", "at3", "ar1", "ar2", ""}, []string{result.Changes[0].ID, result.Changes[1].ID, result.Changes[2].ID, result.Changes[3].ID, result.Changes[4].ID})
+	assert.Equal(t, map[string]interface{}{"name": "new-logs", "target_type": "cloud_logs", "cloudlogs_endpoint": map[string]interface{}{"target_crn": "crn:logs"}, "region": "eu-de"}, atracker.bodies["POST /api/v2/targets"])
+	assert.Equal(t, map[string]interface{}{"name": "regional", "rules": []interface{}{
+		map[string]interface{}{"target_ids": []interface{}{"at3", "at1"}, "locations": []interface{}{"eu-de"}},
+	}}, atracker.bodies["POST /api/v2/routes"])
+	assert.Equal(t, map[string]interface{}{
+		"default_targets": []interface{}{"at3"}, "permitted_target_regions": []interface{}{"us-south", "us-east"},
+		"metadata_region_primary": "eu-de", "private_api_endpoint_only": true,
+	}, atracker.bodies["PUT /api/v2/settings"])
+
+	// The settings are left unchanged.
+	delete(atracker.bodies, "PUT /api/v2/settings")
+	result, err = Import(context.Background(), bundle, routers, &ImportOptions{SkipSettings: true})
+	assert.Nil(t, err)
+	assert.Len(t, result.Changes, 4)
+	assert.NotContains(t, atracker.bodies, "PUT /api/v2/settings")
+}
+
+func TestImportSettingsWithoutDefaultTargets(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+	atracker.responses["PUT /api/v2/settings"] = `{"default_targets": ["at1"], "metadata_region_primary": "us-south"}`
+
+	// The settings omitted by the bundle keep their current values.
+	bundle := &Bundle{Version: BundleVersion, Services: []ServiceBundle{{
+		Service:  ServiceAtracker,
+		Settings: &BundleSettings{BackupMetadataRegion: "us-east"},
+	}}}
+	result, err := Import(context.Background(), bundle, routers, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []Change{{
+		Service: ServiceAtracker, Kind: ChangeKindSettings, Action: ChangeUpdate,
+		Differences: []string{`backup_metadata_region: null -> "us-east"`},
+	}}, result.Changes)
+	assert.Equal(t, map[string]interface{}{
+		"default_targets": []interface{}{"at1"}, "permitted_target_regions": []interface{}{"us-south", "us-east"},
+		"metadata_region_primary": "us-south", "metadata_region_backup": "us-east", "private_api_endpoint_only": true,
+	}, atracker.bodies["PUT /api/v2/settings"])
+
+	// An explicit false is set rather than kept.
+	bundle.Services[0].Settings = &BundleSettings{PrivateAPIEndpointOnly: core.BoolPtr(false)}
+	result, err = Import(context.Background(), bundle, routers, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`private_api_endpoint_only: true -> false`}, result.Changes[0].Differences)
+	assert.Equal(t, false, atracker.bodies["PUT /api/v2/settings"]["private_api_endpoint_only"])
+}
+
+func TestImportErrors(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+
+	bundle := &Bundle{Version: BundleVersion, Services: []ServiceBundle{{
+		Service: ServiceMetricsRouter,
+		Routes:  []BundleRoute{{Name: "all", Rules: []BundleRule{{Targets: []string{"missing"}}}}},
+	}}}
+	result, err := Import(context.Background(), bundle, routers, &ImportOptions{DryRun: true})
+	assert.ErrorContains(t, err, "metrics-router: the route 'all' references the target 'missing', which is neither in the bundle nor in the account")
+	assert.Empty(t, result.Changes)
+
+	_, err = Import(context.Background(), bundle, routers[:2], nil)
+	assert.ErrorContains(t, err, "no router for the service 'metrics-router' of the bundle")
+
+	_, err = Import(context.Background(), &Bundle{Version: 2}, routers, nil)
+	assert.ErrorContains(t, err, "unsupported bundle version 2")
+}
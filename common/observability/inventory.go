@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+)
+
+// Inventory : The routing configuration of an account, by service.
+type Inventory struct {
+	Services []*ServiceInventory
+}
+
+// ServiceInventory : The routing configuration of a service.
+type ServiceInventory struct {
+	Service  string
+	Targets  []Target
+	Routes   []Route
+	Settings Settings
+}
+
+// Target returns the target identified by "id", or nil if the service has no such target.
+func (inventory *ServiceInventory) Target(id string) Target {
+	for _, target := range inventory.Targets {
+		if target.ID() == id {
+			return target
+		}
+	}
+	return nil
+}
+
+// Collect retrieves the targets, routes and settings of each of "routers".
+func Collect(ctx context.Context, routers ...Router) (*Inventory, error) {
+	inventory := &Inventory{}
+	for _, router := range routers {
+		service := &ServiceInventory{Service: router.Service()}
+		var err error
+		if service.Targets, err = router.ListTargets(ctx); err != nil {
+			return nil, err
+		}
+		if service.Routes, err = router.ListRoutes(ctx); err != nil {
+			return nil, err
+		}
+		if service.Settings, err = router.GetSettings(ctx); err != nil {
+			return nil, err
+		}
+		inventory.Services = append(inventory.Services, service)
+	}
+	return inventory, nil
+}
+
+// The kinds of findings reported by Audit.
+const (
+	// A route rule or the default targets reference a target that does not exist.
+	FindingUnknownTarget = "unknown_target"
+
+	// A target is not referenced by any route rule nor by the default targets.
+	FindingUnusedTarget = "unused_target"
+
+	// The last writes to a target failed.
+	FindingWriteFailure = "write_failure"
+
+	// A rule of a route can never apply to an event (see routing.FindUnreachableRules).
+	FindingUnreachableRule = "unreachable_rule"
+
+	// A target is in a region that is not part of the permitted target regions of the settings.
+	FindingRegionNotPermitted = "region_not_permitted"
+)
+
+// Finding : A potential issue in the routing configuration of a service.
+type Finding struct {
+	Service string
+
+	// The kind of finding, such as FindingUnusedTarget.
+	Kind string
+
+	// The ID of the target or route concerned, or the empty string for the settings.
+	ResourceID string
+
+	Message string
+}
+
+// String returns a description of the finding.
+func (finding Finding) String() string {
+	return fmt.Sprintf("%s: %s", finding.Service, finding.Message)
+}
+
+// Audit returns the potential issues in the routing configuration of each service of the inventory.
+func (inventory *Inventory) Audit() (findings []Finding) {
+	for _, service := range inventory.Services {
+		findings = append(findings, service.Audit()...)
+	}
+	return
+}
+
+// Audit returns the potential issues in the routing configuration of the service.
+func (inventory *ServiceInventory) Audit() (findings []Finding) {
+	report := func(kind string, resourceID string, format string, args ...interface{}) {
+		findings = append(findings, Finding{Service: inventory.Service, Kind: kind, ResourceID: resourceID, Message: fmt.Sprintf(format, args...)})
+	}
+
+	used := make(map[string]bool)
+	if inventory.Settings != nil {
+		for _, id := range inventory.Settings.DefaultTargetIDs() {
+			used[id] = true
+			if inventory.Target(id) == nil {
+				report(FindingUnknownTarget, "", "the default target '%s' does not exist", id)
+			}
+		}
+	}
+	routes := make([]routing.Route, len(inventory.Routes))
+	for i, route := range inventory.Routes {
+		for j, r := range route.Rules() {
+			for _, id := range r.TargetIDs() {
+				used[id] = true
+				if inventory.Target(id) == nil {
+					report(FindingUnknownTarget, route.ID(), "rule %d of the route '%s' references the target '%s', which does not exist", j, route.Name(), id)
+				}
+			}
+		}
+		routes[i] = ToRouting(route)
+	}
+	for _, unreachable := range routing.FindUnreachableRules(routes) {
+		route := inventory.Routes[unreachable.RouteIndex]
+		if unreachable.Reason == routing.ReasonShadowed {
+			report(FindingUnreachableRule, route.ID(), "rule %d of the route '%s' is shadowed by rule %d", unreachable.RuleIndex, route.Name(), unreachable.ShadowedBy)
+		} else {
+			report(FindingUnreachableRule, route.ID(), "rule %d of the route '%s' never matches", unreachable.RuleIndex, route.Name())
+		}
+	}
+
+	var permittedRegions []string
+	if inventory.Settings != nil {
+		permittedRegions = inventory.Settings.PermittedTargetRegions()
+	}
+	for _, target := range inventory.Targets {
+		if !used[target.ID()] {
+			report(FindingUnusedTarget, target.ID(), "the target '%s' is not used by any route nor by the default targets", target.Name())
+		}
+		if status, reason := target.WriteStatus(); status == "failed" {
+			report(FindingWriteFailure, target.ID(), "the last writes to the target '%s' failed: %s", target.Name(), reason)
+		}
+		if len(permittedRegions) > 0 && target.Region() != "" && !slices.Contains(permittedRegions, target.Region()) {
+			report(FindingRegionNotPermitted, target.ID(), "the target '%s' is in the region '%s', which is not permitted", target.Name(), target.Region())
+		}
+	}
+	return
+}
@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+)
+
+// NewMetricsRouter returns a Router over a Metrics Router client.
+func NewMetricsRouter(metricsRouter *metricsrouterv3.MetricsRouterV3) Router {
+	return &routerAdapter{
+		service:    ServiceMetricsRouter,
+		targetType: metricsrouterv3.TargetTargetTypeSysdigMonitorConst,
+		api:        &metricsRouterAPI{client: metricsRouter},
+	}
+}
+
+type metricsRouterAPI struct {
+	client *metricsrouterv3.MetricsRouterV3
+}
+
+func (api *metricsRouterAPI) listTargets(ctx context.Context) ([]Target, error) {
+	result, _, err := api.client.ListTargetsWithContext(ctx, api.client.NewListTargetsOptions())
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]Target, len(result.Targets))
+	for i := range result.Targets {
+		targets[i] = metricsRouterTarget(&result.Targets[i])
+	}
+	return targets, nil
+}
+
+func (api *metricsRouterAPI) listRoutes(ctx context.Context) ([]Route, error) {
+	result, _, err := api.client.ListRoutesWithContext(ctx, api.client.NewListRoutesOptions())
+	if err != nil {
+		return nil, err
+	}
+	routes := make([]Route, len(result.Routes))
+	for i := range result.Routes {
+		routes[i] = metricsRouterRoute(&result.Routes[i])
+	}
+	return routes, nil
+}
+
+func (api *metricsRouterAPI) getSettings(ctx context.Context) (Settings, error) {
+	result, _, err := api.client.GetSettingsWithContext(ctx, api.client.NewGetSettingsOptions())
+	if err != nil {
+		return nil, err
+	}
+	return metricsRouterSettings(result), nil
+}
+
+func (api *metricsRouterAPI) createTarget(ctx context.Context, spec *TargetSpec) (Target, error) {
+	options := api.client.NewCreateTargetOptions(spec.Name, spec.DestinationCRN)
+	if spec.Region != "" {
+		options.SetRegion(spec.Region)
+	}
+	if spec.ManagedBy != "" {
+		options.SetManagedBy(spec.ManagedBy)
+	}
+	result, _, err := api.client.CreateTargetWithContext(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return metricsRouterTarget(result), nil
+}
+
+func (api *metricsRouterAPI) createRoute(ctx context.Context, spec *RouteSpec) (Route, error) {
+	rules := make([]metricsrouterv3.RulePrototype, len(spec.Rules))
+	for i, r := range spec.Rules {
+		rules[i] = metricsrouterv3.RulePrototype{
+			Targets:          []metricsrouterv3.TargetIdentity{},
+			InclusionFilters: []metricsrouterv3.InclusionFilterPrototype{},
+		}
+		if r.Action != "" {
+			rules[i].Action = core.StringPtr(r.Action)
+		}
+		for _, targetID := range r.TargetIDs {
+			rules[i].Targets = append(rules[i].Targets, metricsrouterv3.TargetIdentity{ID: core.StringPtr(targetID)})
+		}
+		for _, filter := range r.InclusionFilters {
+			rules[i].InclusionFilters = append(rules[i].InclusionFilters, metricsrouterv3.InclusionFilterPrototype{
+				Operand:  core.StringPtr(filter.Operand),
+				Operator: core.StringPtr(filter.Operator),
+				Values:   filter.Values,
+			})
+		}
+	}
+	options := api.client.NewCreateRouteOptions(spec.Name, rules)
+	if spec.ManagedBy != "" {
+		options.SetManagedBy(spec.ManagedBy)
+	}
+	result, _, err := api.client.CreateRouteWithContext(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return metricsRouterRoute(result), nil
+}
+
+func (api *metricsRouterAPI) updateSettings(ctx context.Context, spec *SettingsSpec) (Settings, error) {
+	options := api.client.NewUpdateSettingsOptions()
+	for _, targetID := range spec.DefaultTargetIDs {
+		options.DefaultTargets = append(options.DefaultTargets, metricsrouterv3.TargetIdentity{ID: core.StringPtr(targetID)})
+	}
+	options.PermittedTargetRegions = spec.PermittedTargetRegions
+	if spec.PrimaryMetadataRegion != "" {
+		options.SetPrimaryMetadataRegion(spec.PrimaryMetadataRegion)
+	}
+	if spec.BackupMetadataRegion != "" {
+		options.SetBackupMetadataRegion(spec.BackupMetadataRegion)
+	}
+	options.SetPrivateAPIEndpointOnly(spec.PrivateAPIEndpointOnly)
+	result, _, err := api.client.UpdateSettingsWithContext(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return metricsRouterSettings(result), nil
+}
+
+// metricsRouterTarget converts a Metrics Router target.
+func metricsRouterTarget(model *metricsrouterv3.Target) Target {
+	t := &routerTarget{
+		service:        ServiceMetricsRouter,
+		id:             core.StringNilMapper(model.ID),
+		name:           core.StringNilMapper(model.Name),
+		crn:            core.StringNilMapper(model.CRN),
+		targetType:     core.StringNilMapper(model.TargetType),
+		destinationCRN: core.StringNilMapper(model.DestinationCRN),
+		region:         core.StringNilMapper(model.Region),
+		managedBy:      core.StringNilMapper(model.ManagedBy),
+		model:          model,
+	}
+	if model.WriteStatus != nil {
+		t.writeStatus = core.StringNilMapper(model.WriteStatus.Status)
+		t.writeFailure = core.StringNilMapper(model.WriteStatus.ReasonForLastFailure)
+	}
+	return t
+}
+
+// metricsRouterRoute converts a Metrics Router route.
+func metricsRouterRoute(model *metricsrouterv3.Route) Route {
+	r := &routerRoute{
+		service:   ServiceMetricsRouter,
+		id:        core.StringNilMapper(model.ID),
+		name:      core.StringNilMapper(model.Name),
+		crn:       core.StringNilMapper(model.CRN),
+		managedBy: core.StringNilMapper(model.ManagedBy),
+		rules:     make([]Rule, len(model.Rules)),
+		model:     model,
+	}
+	for i, modelRule := range model.Rules {
+		var targetIDs []string
+		for _, target := range modelRule.Targets {
+			targetIDs = append(targetIDs, core.StringNilMapper(target.ID))
+		}
+		var inclusionFilters []routing.InclusionFilter
+		for _, filter := range modelRule.InclusionFilters {
+			inclusionFilters = append(inclusionFilters, routing.InclusionFilter{
+				Operand:  core.StringNilMapper(filter.Operand),
+				Operator: core.StringNilMapper(filter.Operator),
+				Values:   filter.Values,
+			})
+		}
+		r.rules[i] = newRule(core.StringNilMapper(modelRule.Action), targetIDs, inclusionFilters)
+	}
+	return r
+}
+
+// metricsRouterSettings converts the Metrics Router settings.
+func metricsRouterSettings(model *metricsrouterv3.Setting) Settings {
+	s := &routerSettings{
+		service:                ServiceMetricsRouter,
+		permittedTargetRegions: model.PermittedTargetRegions,
+		primaryMetadataRegion:  core.StringNilMapper(model.PrimaryMetadataRegion),
+		backupMetadataRegion:   core.StringNilMapper(model.BackupMetadataRegion),
+		privateAPIEndpointOnly: model.PrivateAPIEndpointOnly != nil && *model.PrivateAPIEndpointOnly,
+		model:                  model,
+	}
+	for _, target := range model.DefaultTargets {
+		s.defaultTargetIDs = append(s.defaultTargetIDs, core.StringNilMapper(target.ID))
+	}
+	return s
+}
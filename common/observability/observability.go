@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package observability provides a common view of the routing configuration of Activity Tracker (atrackerv2),
+// Logs Router (logsrouterv3) and Metrics Router (metricsrouterv3), whose APIs are similar but use different models.
+// A Router adapts the client of one of these services:
+//
+//	routers := []observability.Router{
+//		observability.NewAtrackerRouter(atrackerService),
+//		observability.NewLogsRouter(logsRouterService),
+//		observability.NewMetricsRouter(metricsRouterService),
+//	}
+//	inventory, err := observability.Collect(ctx, routers...)
+//	for _, finding := range inventory.Audit() {
+//		fmt.Println(finding)
+//	}
+//
+// The routing configuration can also be exported as a Bundle, which references the targets by name, and imported
+// in another account or region:
+//
+//	bundle, err := observability.Export(ctx, sourceRouters...)
+//	err = bundle.Write(file, observability.BundleFormatYAML)
+//	...
+//	result, err := observability.Import(ctx, bundle, destinationRouters, &observability.ImportOptions{DryRun: true})
+//	err = result.WriteDiff(os.Stdout)
+//
+// The rules of Activity Tracker routes, which hold a list of locations, are presented as rules with a single
+// "location in" inclusion filter and the "send" action, like the rules of the other services.
+package observability
+
+import (
+	"context"
+
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+)
+
+// The services adapted by a Router.
+const (
+	ServiceAtracker      = "atracker"
+	ServiceLogsRouter    = "logs-router"
+	ServiceMetricsRouter = "metrics-router"
+)
+
+// The types of targets.
+const (
+	TargetTypeCloudLogs          = "cloud_logs"
+	TargetTypeCloudObjectStorage = "cloud_object_storage"
+	TargetTypeEventStreams       = "event_streams"
+	TargetTypeSysdigMonitor      = "sysdig_monitor"
+)
+
+// Target : A target of a service, where events are sent.
+type Target interface {
+	// The service of the target, such as ServiceLogsRouter.
+	Service() string
+
+	ID() string
+	Name() string
+	CRN() string
+
+	// The type of the target, such as TargetTypeCloudLogs.
+	TargetType() string
+
+	// The CRN of the instance that receives the events (e.g. an IBM Cloud Logs instance).
+	DestinationCRN() string
+
+	// The region of the target.
+	Region() string
+
+	// The status of the last writes to the target (e.g. "success" or "failed"), and the reason of the last
+	// failure, if any.
+	WriteStatus() (status string, reason string)
+
+	// The entity that manages the target, if any (e.g. "enterprise").
+	ManagedBy() string
+
+	// The model of the target returned by the service, such as *logsrouterv3.Target.
+	Model() interface{}
+}
+
+// Route : A route of a service, with its ordered rules.
+type Route interface {
+	Service() string
+	ID() string
+	Name() string
+	CRN() string
+	ManagedBy() string
+	Rules() []Rule
+
+	// The model of the route returned by the service, such as *logsrouterv3.Route.
+	Model() interface{}
+}
+
+// Rule : A rule of a route.
+type Rule interface {
+	// routing.ActionSend or routing.ActionDrop.
+	Action() string
+
+	// The IDs of the targets of the rule.
+	TargetIDs() []string
+
+	// The filters that must all match an event for the rule to apply.
+	InclusionFilters() []routing.InclusionFilter
+}
+
+// Settings : The account-level settings of a service.
+type Settings interface {
+	Service() string
+
+	// The IDs of the targets that receive the events not matched by any route.
+	DefaultTargetIDs() []string
+
+	// The regions where targets can be created. Empty if any region is permitted.
+	PermittedTargetRegions() []string
+
+	PrimaryMetadataRegion() string
+	BackupMetadataRegion() string
+	PrivateAPIEndpointOnly() bool
+
+	// The model of the settings returned by the service, such as *logsrouterv3.Setting.
+	Model() interface{}
+}
+
+// TargetSpec : The definition of a target to create.
+type TargetSpec struct {
+	Name string `json:"name"`
+
+	// The type of the target, such as TargetTypeCloudLogs.
+	TargetType string `json:"target_type"`
+
+	// The CRN of the instance that receives the events.
+	DestinationCRN string `json:"destination_crn"`
+
+	// The region of the target. Defaults to the region of the service endpoint.
+	Region string `json:"region,omitempty"`
+
+	// The bucket of an Activity Tracker target of type TargetTypeCloudObjectStorage.
+	COS *COSEndpointSpec `json:"cos_endpoint,omitempty"`
+
+	// The topic of an Activity Tracker target of type TargetTypeEventStreams.
+	EventStreams *EventStreamsEndpointSpec `json:"eventstreams_endpoint,omitempty"`
+
+	ManagedBy string `json:"managed_by,omitempty"`
+}
+
+// COSEndpointSpec : The Cloud Object Storage bucket of an Activity Tracker target.
+type COSEndpointSpec struct {
+	Endpoint                string `json:"endpoint"`
+	Bucket                  string `json:"bucket"`
+	APIKey                  string `json:"api_key,omitempty"`
+	ServiceToServiceEnabled bool   `json:"service_to_service_enabled,omitempty"`
+}
+
+// EventStreamsEndpointSpec : The Event Streams topic of an Activity Tracker target.
+type EventStreamsEndpointSpec struct {
+	Brokers                 []string `json:"brokers"`
+	Topic                   string   `json:"topic"`
+	APIKey                  string   `json:"api_key,omitempty"`
+	ServiceToServiceEnabled bool     `json:"service_to_service_enabled,omitempty"`
+}
+
+// RouteSpec : The definition of a route to create.
+type RouteSpec struct {
+	Name      string     `json:"name"`
+	Rules     []RuleSpec `json:"rules"`
+	ManagedBy string     `json:"managed_by,omitempty"`
+}
+
+// RuleSpec : The definition of a rule of a route to create. Activity Tracker only supports the "send" action and
+// a single "location" filter with the "in" or "is" operator.
+type RuleSpec struct {
+	Action           string                    `json:"action,omitempty"`
+	TargetIDs        []string                  `json:"target_ids"`
+	InclusionFilters []routing.InclusionFilter `json:"inclusion_filters,omitempty"`
+}
+
+// SettingsSpec : The account-level settings of a service to set.
+type SettingsSpec struct {
+	// The IDs of the targets that receive the events not matched by any route. An empty list leaves the
+	// default targets of Logs Router and Metrics Router unchanged, but clears those of Activity Tracker,
+	// whose settings are replaced as a whole.
+	DefaultTargetIDs []string
+
+	PermittedTargetRegions []string
+	PrimaryMetadataRegion  string
+	BackupMetadataRegion   string
+	PrivateAPIEndpointOnly bool
+}
+
+// Router : The routing API of a service.
+type Router interface {
+	// The service, such as ServiceLogsRouter.
+	Service() string
+
+	ListTargets(ctx context.Context) ([]Target, error)
+	ListRoutes(ctx context.Context) ([]Route, error)
+	GetSettings(ctx context.Context) (Settings, error)
+
+	CreateTarget(ctx context.Context, spec *TargetSpec) (Target, error)
+	CreateRoute(ctx context.Context, spec *RouteSpec) (Route, error)
+	UpdateSettings(ctx context.Context, spec *SettingsSpec) (Settings, error)
+}
+
+// rule : A Rule made of its fields.
+type rule struct {
+	action           string
+	targetIDs        []string
+	inclusionFilters []routing.InclusionFilter
+}
+
+func (r *rule) Action() string {
+	return r.action
+}
+
+func (r *rule) TargetIDs() []string {
+	return r.targetIDs
+}
+
+func (r *rule) InclusionFilters() []routing.InclusionFilter {
+	return r.inclusionFilters
+}
+
+// ToRouting returns "route" in the form of the routing package, to evaluate it or find its unreachable rules.
+func ToRouting(route Route) routing.Route {
+	result := routing.Route{ID: route.ID(), Name: route.Name()}
+	for _, r := range route.Rules() {
+		result.Rules = append(result.Rules, routing.Rule{Action: r.Action(), Targets: r.TargetIDs(), InclusionFilters: r.InclusionFilters()})
+	}
+	return result
+}
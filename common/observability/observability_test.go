@@ -0,0 +1,232 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeService serves canned JSON responses keyed by method and path, and records the bodies of the requests
+// other than GET.
+type fakeService struct {
+	*httptest.Server
+	responses map[string]string
+	bodies    map[string]map[string]interface{}
+}
+
+func newFakeService(t *testing.T, responses map[string]string) *fakeService {
+	service := &fakeService{responses: responses, bodies: make(map[string]map[string]interface{})}
+	service.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		key := req.Method + " " + req.URL.Path
+		if req.Method != http.MethodGet {
+			data, err := io.ReadAll(req.Body)
+			assert.Nil(t, err)
+			var body map[string]interface{}
+			assert.Nil(t, json.Unmarshal(data, &body))
+			service.bodies[key] = body
+		}
+		response, ok := service.responses[key]
+		if !ok {
+			res.WriteHeader(http.StatusNotFound)
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodPost {
+			res.WriteHeader(http.StatusCreated)
+		}
+		_, err := io.WriteString(res, response)
+		assert.Nil(t, err)
+	}))
+	return service
+}
+
+func newRouters(t *testing.T) (atracker, logs, metrics *fakeService, routers []Router) {
+	atracker = newFakeService(t, map[string]string{
+		"GET /api/v2/targets": `{"targets": [
+			{"id": "at1", "name": "audit-bucket", "crn": "crn:at1", "target_type": "cloud_object_storage", "region": "us-south",
+			 "cos_endpoint": {"endpoint": "s3.us.cloud-object-storage.appdomain.cloud", "target_crn": "crn:cos", "bucket": "audit", "service_to_service_enabled": true},
+			 "write_status": {"status": "success"}, "managed_by": "account"},
+			{"id": "at2", "name": "old-logs", "crn": "crn:at2", "target_type": "cloud_logs", "region": "eu-de",
+			 "cloudlogs_endpoint": {"target_crn": "crn:logs-eu"}, "write_status": {"status": "failed", "reason_for_last_failure": "Forbidden"}}]}`,
+		"GET /api/v2/routes":   `{"routes": [{"id": "ar1", "name": "all-events", "crn": "crn:ar1", "rules": [{"target_ids": ["at1"], "locations": ["*"]}]}]}`,
+		"GET /api/v2/settings": `{"default_targets": ["at1"], "permitted_target_regions": ["us-south", "us-east"], "metadata_region_primary": "us-south", "private_api_endpoint_only": true}`,
+		"POST /api/v2/routes":  `{"id": "ar2", "name": "regional", "crn": "crn:ar2", "rules": [{"target_ids": ["at1"], "locations": ["us-south"]}]}`,
+		"POST /api/v2/targets": `{"id": "at3", "name": "new-logs", "crn": "crn:at3", "target_type": "cloud_logs", "cloudlogs_endpoint": {"target_crn": "crn:logs"}}`,
+	})
+	logs = newFakeService(t, map[string]string{
+		"GET /targets": `{"targets": [{"id": "lt1", "name": "platform-logs", "crn": "crn:lt1", "destination_crn": "crn:logs", "target_type": "cloud_logs", "region": "us-south", "write_status": {"status": "success"}}]}`,
+		"GET /routes": `{"routes": [{"id": "lr1", "name": "regional", "crn": "crn:lr1", "rules": [
+			{"action": "send", "targets": [{"id": "lt1"}], "inclusion_filters": [{"operand": "location", "operator": "in", "values": ["us-south", "us-east"]}]},
+			{"targets": [{"id": "lt1"}], "inclusion_filters": [{"operand": "location", "operator": "is", "values": ["us-south"]}]},
+			{"action": "drop", "targets": [], "inclusion_filters": [{"operand": "location", "operator": "is", "values": ["eu-de"]}]},
+			{"action": "send", "targets": [{"id": "lt9"}], "inclusion_filters": [{"operand": "location", "operator": "is", "values": ["jp-tok"]}]}]}]}`,
+		"GET /settings": `{"default_targets": [{"id": "lt1"}], "permitted_target_regions": [], "primary_metadata_region": "us-south", "backup_metadata_region": "us-east"}`,
+		"POST /targets": `{"id": "lt2", "name": "new-logs", "crn": "crn:lt2", "destination_crn": "crn:logs", "target_type": "cloud_logs"}`,
+		"POST /routes":  `{"id": "lr2", "name": "new-route", "crn": "crn:lr2", "rules": []}`,
+	})
+	metrics = newFakeService(t, map[string]string{
+		"GET /targets":  `{"targets": [{"id": "mt1", "name": "monitoring", "crn": "crn:mt1", "destination_crn": "crn:sysdig", "target_type": "sysdig_monitor", "region": "us-south"}]}`,
+		"GET /routes":   `{"routes": []}`,
+		"GET /settings": `{"default_targets": [], "permitted_target_regions": [], "primary_metadata_region": "us-south"}`,
+	})
+
+	atrackerService, err := atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{URL: atracker.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	logsRouterService, err := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: logs.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	metricsRouterService, err := metricsrouterv3.NewMetricsRouterV3(&metricsrouterv3.MetricsRouterV3Options{URL: metrics.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	routers = []Router{NewAtrackerRouter(atrackerService), NewLogsRouter(logsRouterService), NewMetricsRouter(metricsRouterService)}
+	return
+}
+
+func TestCollect(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+
+	inventory, err := Collect(context.Background(), routers...)
+	assert.Nil(t, err)
+	assert.Len(t, inventory.Services, 3)
+
+	at := inventory.Services[0]
+	assert.Equal(t, ServiceAtracker, at.Service)
+	assert.Len(t, at.Targets, 2)
+	assert.Equal(t, "crn:cos", at.Targets[0].DestinationCRN())
+	assert.Equal(t, TargetTypeCloudObjectStorage, at.Targets[0].TargetType())
+	assert.Equal(t, "account", at.Targets[0].ManagedBy())
+	assert.Equal(t, "crn:logs-eu", at.Targets[1].DestinationCRN())
+	status, reason := at.Targets[1].WriteStatus()
+	assert.Equal(t, "failed", status)
+	assert.Equal(t, "Forbidden", reason)
+	assert.IsType(t, &atrackerv2.Target{}, at.Targets[0].Model())
+	rules := at.Routes[0].Rules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, routing.ActionSend, rules[0].Action())
+	assert.Equal(t, []string{"at1"}, rules[0].TargetIDs())
+	assert.Empty(t, rules[0].InclusionFilters())
+	assert.Equal(t, []string{"at1"}, at.Settings.DefaultTargetIDs())
+	assert.True(t, at.Settings.PrivateAPIEndpointOnly())
+	assert.Equal(t, "us-south", at.Settings.PrimaryMetadataRegion())
+
+	lr := inventory.Services[1]
+	assert.Equal(t, ServiceLogsRouter, lr.Service)
+	assert.Equal(t, "crn:logs", lr.Target("lt1").DestinationCRN())
+	assert.Nil(t, lr.Target("lt9"))
+	rules = lr.Routes[0].Rules()
+	assert.Len(t, rules, 4)
+	assert.Equal(t, routing.ActionSend, rules[1].Action())
+	assert.Equal(t, routing.ActionDrop, rules[2].Action())
+	assert.Equal(t, []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIs, Values: []string{"eu-de"}}}, rules[2].InclusionFilters())
+	assert.Equal(t, []string{"lt1"}, lr.Settings.DefaultTargetIDs())
+	assert.Equal(t, "us-east", lr.Settings.BackupMetadataRegion())
+
+	evaluations := routing.Evaluate([]routing.Route{ToRouting(lr.Routes[0])}, routing.Event{routing.OperandLocation: "us-east"})
+	assert.Equal(t, []string{"lt1"}, evaluations[0].Targets)
+
+	mr := inventory.Services[2]
+	assert.Equal(t, ServiceMetricsRouter, mr.Service)
+	assert.Equal(t, TargetTypeSysdigMonitor, mr.Targets[0].TargetType())
+	assert.Empty(t, mr.Routes)
+
+	delete(metrics.responses, "GET /settings")
+	_, err = Collect(context.Background(), routers...)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), ServiceMetricsRouter)
+}
+
+func TestAudit(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+
+	inventory, err := Collect(context.Background(), routers...)
+	assert.Nil(t, err)
+	assert.Equal(t, []Finding{
+		{Service: ServiceAtracker, Kind: FindingUnusedTarget, ResourceID: "at2", Message: "the target 'old-logs' is not used by any route nor by the default targets"},
+		{Service: ServiceAtracker, Kind: FindingWriteFailure, ResourceID: "at2", Message: "the last writes to the target 'old-logs' failed: Forbidden"},
+		{Service: ServiceAtracker, Kind: FindingRegionNotPermitted, ResourceID: "at2", Message: "the target 'old-logs' is in the region 'eu-de', which is not permitted"},
+		{Service: ServiceLogsRouter, Kind: FindingUnknownTarget, ResourceID: "lr1", Message: "rule 3 of the route 'regional' references the target 'lt9', which does not exist"},
+		{Service: ServiceLogsRouter, Kind: FindingUnreachableRule, ResourceID: "lr1", Message: "rule 1 of the route 'regional' is shadowed by rule 0"},
+		{Service: ServiceMetricsRouter, Kind: FindingUnusedTarget, ResourceID: "mt1", Message: "the target 'monitoring' is not used by any route nor by the default targets"},
+	}, inventory.Audit())
+	assert.Equal(t, "metrics-router: the target 'monitoring' is not used by any route nor by the default targets", inventory.Audit()[5].String())
+}
+
+func TestCreate(t *testing.T) {
+	atracker, logs, metrics, routers := newRouters(t)
+	defer atracker.Close()
+	defer logs.Close()
+	defer metrics.Close()
+	ctx := context.Background()
+
+	target, err := routers[0].CreateTarget(ctx, &TargetSpec{Name: "new-logs", TargetType: TargetTypeCloudLogs, DestinationCRN: "crn:logs", Region: "us-south"})
+	assert.Nil(t, err)
+	assert.Equal(t, "at3", target.ID())
+	assert.Equal(t, map[string]interface{}{"name": "new-logs", "target_type": "cloud_logs", "cloudlogs_endpoint": map[string]interface{}{"target_crn": "crn:logs"}, "region": "us-south"}, atracker.bodies["POST /api/v2/targets"])
+
+	_, err = routers[0].CreateTarget(ctx, &TargetSpec{Name: "bucket", TargetType: TargetTypeCloudObjectStorage, DestinationCRN: "crn:cos"})
+	assert.NotNil(t, err)
+	_, err = routers[0].CreateTarget(ctx, &TargetSpec{Name: "bucket", TargetType: TargetTypeCloudObjectStorage, DestinationCRN: "crn:cos", COS: &COSEndpointSpec{Endpoint: "s3.example.com", Bucket: "audit", ServiceToServiceEnabled: true}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"endpoint": "s3.example.com", "target_crn": "crn:cos", "bucket": "audit", "service_to_service_enabled": true}, atracker.bodies["POST /api/v2/targets"]["cos_endpoint"])
+
+	route, err := routers[0].CreateRoute(ctx, &RouteSpec{Name: "regional", Rules: []RuleSpec{
+		{TargetIDs: []string{"at1"}, InclusionFilters: []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIn, Values: []string{"us-south"}}}},
+		{TargetIDs: []string{"at1"}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, "ar2", route.ID())
+	assert.Equal(t, map[string]interface{}{"name": "regional", "rules": []interface{}{
+		map[string]interface{}{"target_ids": []interface{}{"at1"}, "locations": []interface{}{"us-south"}},
+		map[string]interface{}{"target_ids": []interface{}{"at1"}, "locations": []interface{}{"*"}},
+	}}, atracker.bodies["POST /api/v2/routes"])
+	_, err = routers[0].CreateRoute(ctx, &RouteSpec{Name: "drop", Rules: []RuleSpec{{Action: routing.ActionDrop}}})
+	assert.NotNil(t, err)
+
+	target, err = routers[1].CreateTarget(ctx, &TargetSpec{Name: "new-logs", DestinationCRN: "crn:logs", ManagedBy: "enterprise"})
+	assert.Nil(t, err)
+	assert.Equal(t, "lt2", target.ID())
+	assert.Equal(t, map[string]interface{}{"name": "new-logs", "destination_crn": "crn:logs", "managed_by": "enterprise"}, logs.bodies["POST /targets"])
+
+	_, err = routers[1].CreateRoute(ctx, &RouteSpec{Name: "new-route", Rules: []RuleSpec{
+		{Action: routing.ActionDrop, InclusionFilters: []routing.InclusionFilter{{Operand: routing.OperandLocation, Operator: routing.OperatorIs, Values: []string{"eu-de"}}}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "new-route", "rules": []interface{}{
+		map[string]interface{}{"action": "drop", "targets": []interface{}{}, "inclusion_filters": []interface{}{
+			map[string]interface{}{"operand": "location", "operator": "is", "values": []interface{}{"eu-de"}},
+		}},
+	}}, logs.bodies["POST /routes"])
+
+	_, err = routers[2].CreateTarget(ctx, &TargetSpec{Name: "logs", TargetType: TargetTypeCloudLogs, DestinationCRN: "crn:logs"})
+	assert.NotNil(t, err)
+}
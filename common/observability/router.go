@@ -0,0 +1,239 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+)
+
+// routerAPI : The calls of the client of Logs Router or Metrics Router, with their models converted to the
+// types of this package.
+type routerAPI interface {
+	listTargets(ctx context.Context) ([]Target, error)
+	listRoutes(ctx context.Context) ([]Route, error)
+	getSettings(ctx context.Context) (Settings, error)
+	createTarget(ctx context.Context, spec *TargetSpec) (Target, error)
+	createRoute(ctx context.Context, spec *RouteSpec) (Route, error)
+	updateSettings(ctx context.Context, spec *SettingsSpec) (Settings, error)
+}
+
+// routerAdapter : The Router of Logs Router and Metrics Router, whose APIs only differ in their models and in
+// the type of their targets.
+type routerAdapter struct {
+	service    string
+	targetType string
+	api        routerAPI
+}
+
+func (router *routerAdapter) Service() string {
+	return router.service
+}
+
+func (router *routerAdapter) ListTargets(ctx context.Context) ([]Target, error) {
+	targets, err := router.api.listTargets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the targets of %s: %w", router.service, err)
+	}
+	return targets, nil
+}
+
+func (router *routerAdapter) ListRoutes(ctx context.Context) ([]Route, error) {
+	routes, err := router.api.listRoutes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the routes of %s: %w", router.service, err)
+	}
+	return routes, nil
+}
+
+func (router *routerAdapter) GetSettings(ctx context.Context) (Settings, error) {
+	settings, err := router.api.getSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the settings of %s: %w", router.service, err)
+	}
+	return settings, nil
+}
+
+func (router *routerAdapter) CreateTarget(ctx context.Context, spec *TargetSpec) (Target, error) {
+	if spec.TargetType != "" && spec.TargetType != router.targetType {
+		return nil, fmt.Errorf("%s does not support targets of type '%s'", router.service, spec.TargetType)
+	}
+	target, err := router.api.createTarget(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the target '%s' of %s: %w", spec.Name, router.service, err)
+	}
+	return target, nil
+}
+
+func (router *routerAdapter) CreateRoute(ctx context.Context, spec *RouteSpec) (Route, error) {
+	route, err := router.api.createRoute(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the route '%s' of %s: %w", spec.Name, router.service, err)
+	}
+	return route, nil
+}
+
+func (router *routerAdapter) UpdateSettings(ctx context.Context, spec *SettingsSpec) (Settings, error) {
+	settings, err := router.api.updateSettings(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error updating the settings of %s: %w", router.service, err)
+	}
+	return settings, nil
+}
+
+// routerTarget : A Target made of its fields.
+type routerTarget struct {
+	service        string
+	id             string
+	name           string
+	crn            string
+	targetType     string
+	destinationCRN string
+	region         string
+	writeStatus    string
+	writeFailure   string
+	managedBy      string
+	model          interface{}
+}
+
+func (t *routerTarget) Service() string {
+	return t.service
+}
+
+func (t *routerTarget) ID() string {
+	return t.id
+}
+
+func (t *routerTarget) Name() string {
+	return t.name
+}
+
+func (t *routerTarget) CRN() string {
+	return t.crn
+}
+
+func (t *routerTarget) TargetType() string {
+	return t.targetType
+}
+
+func (t *routerTarget) DestinationCRN() string {
+	return t.destinationCRN
+}
+
+func (t *routerTarget) Region() string {
+	return t.region
+}
+
+func (t *routerTarget) WriteStatus() (string, string) {
+	return t.writeStatus, t.writeFailure
+}
+
+func (t *routerTarget) ManagedBy() string {
+	return t.managedBy
+}
+
+func (t *routerTarget) Model() interface{} {
+	return t.model
+}
+
+// routerRoute : A Route made of its fields.
+type routerRoute struct {
+	service   string
+	id        string
+	name      string
+	crn       string
+	managedBy string
+	rules     []Rule
+	model     interface{}
+}
+
+func (r *routerRoute) Service() string {
+	return r.service
+}
+
+func (r *routerRoute) ID() string {
+	return r.id
+}
+
+func (r *routerRoute) Name() string {
+	return r.name
+}
+
+func (r *routerRoute) CRN() string {
+	return r.crn
+}
+
+func (r *routerRoute) ManagedBy() string {
+	return r.managedBy
+}
+
+func (r *routerRoute) Rules() []Rule {
+	return r.rules
+}
+
+func (r *routerRoute) Model() interface{} {
+	return r.model
+}
+
+// newRule returns a Rule made of its fields. An empty action defaults to routing.ActionSend.
+func newRule(action string, targetIDs []string, inclusionFilters []routing.InclusionFilter) *rule {
+	if action == "" {
+		action = routing.ActionSend
+	}
+	return &rule{action: action, targetIDs: targetIDs, inclusionFilters: inclusionFilters}
+}
+
+// routerSettings : Settings made of their fields.
+type routerSettings struct {
+	service                string
+	defaultTargetIDs       []string
+	permittedTargetRegions []string
+	primaryMetadataRegion  string
+	backupMetadataRegion   string
+	privateAPIEndpointOnly bool
+	model                  interface{}
+}
+
+func (s *routerSettings) Service() string {
+	return s.service
+}
+
+func (s *routerSettings) DefaultTargetIDs() []string {
+	return s.defaultTargetIDs
+}
+
+func (s *routerSettings) PermittedTargetRegions() []string {
+	return s.permittedTargetRegions
+}
+
+func (s *routerSettings) PrimaryMetadataRegion() string {
+	return s.primaryMetadataRegion
+}
+
+func (s *routerSettings) BackupMetadataRegion() string {
+	return s.backupMetadataRegion
+}
+
+func (s *routerSettings) PrivateAPIEndpointOnly() bool {
+	return s.privateAPIEndpointOnly
+}
+
+func (s *routerSettings) Model() interface{} {
+	return s.model
+}
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// Pager is the set of methods implemented by every generated pager
+// (e.g. ResourceInstancesPager, AccessGroupsPager) that retrieves pages of items of type T.
+type Pager[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// Items returns an iterator that yields each item retrieved by "pager", one page at a time.
+//
+// Pages are retrieved on demand, so a caller that stops ranging over the iterator early
+// will not trigger any further requests. If a page cannot be retrieved, or if "ctx" is
+// cancelled before the next page is requested, the error is yielded along with the zero
+// value of T and the iteration ends.
+//
+// Parameters:
+//
+//	ctx - the Context used for each page request
+//	pager - the pager used to retrieve the pages of results
+//
+// Returns:
+//
+//	an iterator over the items and any error encountered while retrieving them
+func Items[T any](ctx context.Context, pager Pager[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
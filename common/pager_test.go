@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPager struct {
+	pages    [][]string
+	failAt   int
+	requests int
+}
+
+func (pager *testPager) HasNext() bool {
+	return pager.requests < len(pager.pages)
+}
+
+func (pager *testPager) GetNextWithContext(ctx context.Context) ([]string, error) {
+	pager.requests++
+	if pager.failAt == pager.requests {
+		return nil, errors.New("page error")
+	}
+	return pager.pages[pager.requests-1], nil
+}
+
+func TestItems(t *testing.T) {
+	pager := &testPager{pages: [][]string{{"a", "b"}, {"c"}, {}, {"d"}}}
+
+	var items []string
+	for item, err := range Items[string](context.Background(), pager) {
+		assert.Nil(t, err)
+		items = append(items, item)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, items)
+	assert.Equal(t, 4, pager.requests)
+}
+
+func TestItemsBreak(t *testing.T) {
+	pager := &testPager{pages: [][]string{{"a", "b"}, {"c"}}}
+
+	var items []string
+	for item, err := range Items[string](context.Background(), pager) {
+		assert.Nil(t, err)
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Equal(t, 1, pager.requests)
+}
+
+func TestItemsError(t *testing.T) {
+	pager := &testPager{pages: [][]string{{"a"}, {"b"}, {"c"}}, failAt: 2}
+
+	var items []string
+	var errs []error
+	for item, err := range Items[string](context.Background(), pager) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []string{"a"}, items)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "page error")
+	assert.Equal(t, 2, pager.requests)
+}
+
+func TestItemsCancelled(t *testing.T) {
+	pager := &testPager{pages: [][]string{{"a"}, {"b"}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var items []string
+	var errs []error
+	for item, err := range Items[string](ctx, pager) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+		cancel()
+	}
+	assert.Equal(t, []string{"a"}, items)
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+	assert.Equal(t, 1, pager.requests)
+}
@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package routing evaluates Logs Router and Metrics Router routes locally, to predict where an event
+// (a log or a metric) of a given location would be routed, and detects the rules of a route that can
+// never match. The logsrouterv3 and metricsrouterv3 packages convert their routes to the types of this
+// package and back:
+//
+//	routes, _, err := logsRouterService.ListRoutes(logsRouterService.NewListRoutesOptions())
+//	for _, evaluation := range logsrouterv3.EvaluateRoutes(routes.Routes, routing.Event{routing.OperandLocation: "us-south"}) {
+//		fmt.Println(*evaluation.Route.Name, evaluation.Action, len(evaluation.Targets))
+//	}
+//
+// The rules of a route are evaluated in order, and the first rule whose inclusion filters all match the
+// event wins. A rule without inclusion filters matches every event. If no rule of a route matches, the route
+// does not apply to the event.
+package routing
+
+import (
+	"slices"
+)
+
+// Actions of a rule.
+const (
+	ActionSend = "send"
+	ActionDrop = "drop"
+)
+
+// Operators of an inclusion filter.
+const (
+	OperatorIs = "is"
+	OperatorIn = "in"
+)
+
+// Operands of an inclusion filter. Logs Router only supports OperandLocation.
+const (
+	OperandLocation        = "location"
+	OperandResource        = "resource"
+	OperandResourceType    = "resource_type"
+	OperandServiceInstance = "service_instance"
+	OperandServiceName     = "service_name"
+)
+
+// Event : The attributes of an event, keyed by operand (e.g. OperandLocation).
+type Event map[string]string
+
+// Route : A route and its ordered rules.
+type Route struct {
+	ID    string
+	Name  string
+	Rules []Rule
+}
+
+// Rule : A rule of a route.
+type Rule struct {
+	// ActionSend or ActionDrop. Defaults to ActionSend.
+	Action string
+
+	// The IDs of the targets the matching events are sent to.
+	Targets []string
+
+	// The filters that must all match an event for the rule to apply.
+	InclusionFilters []InclusionFilter
+}
+
+// InclusionFilter : A condition on an attribute of an event.
+type InclusionFilter struct {
+	Operand  string   `json:"operand"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// Evaluation : The outcome of the evaluation of a route for an event.
+type Evaluation struct {
+	// The index of the route in the evaluated routes.
+	RouteIndex int
+
+	// The index of the rule of the route that matched the event, or -1 if no rule matched.
+	RuleIndex int
+
+	// The action of the matched rule, or the empty string if no rule matched.
+	Action string
+
+	// The IDs of the targets the event is sent to, empty unless the action is ActionSend.
+	Targets []string
+}
+
+// Matched returns true if a rule of the route matched the event.
+func (evaluation Evaluation) Matched() bool {
+	return evaluation.RuleIndex >= 0
+}
+
+// Evaluate returns the evaluation of each route for "event", in the order of the routes.
+func Evaluate(routes []Route, event Event) []Evaluation {
+	evaluations := make([]Evaluation, len(routes))
+	for i, route := range routes {
+		evaluations[i] = Evaluation{RouteIndex: i, RuleIndex: -1}
+		for j, rule := range route.Rules {
+			if !rule.Matches(event) {
+				continue
+			}
+			evaluations[i].RuleIndex = j
+			evaluations[i].Action = rule.action()
+			if evaluations[i].Action == ActionSend {
+				evaluations[i].Targets = rule.Targets
+			}
+			break
+		}
+	}
+	return evaluations
+}
+
+// Matches returns true if all the inclusion filters of the rule match "event".
+func (rule Rule) Matches(event Event) bool {
+	for _, filter := range rule.InclusionFilters {
+		if !filter.Matches(event) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rule Rule) action() string {
+	if rule.Action == "" {
+		return ActionSend
+	}
+	return rule.Action
+}
+
+// Matches returns true if the attribute of "event" designated by the operand of the filter is one of the values
+// of the filter. A filter with an unknown operator matches no event.
+func (filter InclusionFilter) Matches(event Event) bool {
+	value, ok := event[filter.Operand]
+	if !ok {
+		return false
+	}
+	switch filter.Operator {
+	case OperatorIs:
+		return len(filter.Values) == 1 && filter.Values[0] == value
+	case OperatorIn:
+		return slices.Contains(filter.Values, value)
+	default:
+		return false
+	}
+}
+
+// Reasons why a rule is unreachable.
+const (
+	// An earlier rule of the route matches every event that the rule matches.
+	ReasonShadowed = "shadowed"
+
+	// No event can match all the inclusion filters of the rule.
+	ReasonNeverMatches = "never_matches"
+)
+
+// UnreachableRule : A rule of a route that can never apply to an event.
+type UnreachableRule struct {
+	// The index of the route in the analyzed routes.
+	RouteIndex int
+
+	// The index of the rule in the route.
+	RuleIndex int
+
+	// ReasonShadowed or ReasonNeverMatches.
+	Reason string
+
+	// The index of the earlier rule that shadows the rule, or -1.
+	ShadowedBy int
+}
+
+// FindUnreachableRules returns the rules of "routes" that can never apply to an event, either because an earlier
+// rule of their route matches every event they match, or because their inclusion filters contradict each other.
+func FindUnreachableRules(routes []Route) (unreachable []UnreachableRule) {
+	for i, route := range routes {
+		constraints := make([]map[string][]string, len(route.Rules))
+		for j, rule := range route.Rules {
+			constraints[j] = rule.constraints()
+			if constraints[j] == nil {
+				unreachable = append(unreachable, UnreachableRule{RouteIndex: i, RuleIndex: j, Reason: ReasonNeverMatches, ShadowedBy: -1})
+				continue
+			}
+			for k := 0; k < j; k++ {
+				if constraints[k] != nil && covers(constraints[k], constraints[j]) {
+					unreachable = append(unreachable, UnreachableRule{RouteIndex: i, RuleIndex: j, Reason: ReasonShadowed, ShadowedBy: k})
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+// constraints returns the values accepted by the rule for each operand it filters on, or nil if no event
+// can match the rule.
+func (rule Rule) constraints() map[string][]string {
+	result := make(map[string][]string)
+	for _, filter := range rule.InclusionFilters {
+		var accepted []string
+		switch filter.Operator {
+		case OperatorIs:
+			if len(filter.Values) == 1 {
+				accepted = filter.Values
+			}
+		case OperatorIn:
+			accepted = filter.Values
+		}
+		if previous, ok := result[filter.Operand]; ok {
+			accepted = slices.DeleteFunc(slices.Clone(accepted), func(value string) bool { return !slices.Contains(previous, value) })
+		}
+		if len(accepted) == 0 {
+			return nil
+		}
+		result[filter.Operand] = accepted
+	}
+	return result
+}
+
+// covers returns true if every event accepted by the constraints "narrow" is accepted by the constraints "wide".
+func covers(wide map[string][]string, narrow map[string][]string) bool {
+	for operand, wideValues := range wide {
+		narrowValues, ok := narrow[operand]
+		if !ok {
+			return false
+		}
+		for _, value := range narrowValues {
+			if !slices.Contains(wideValues, value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func location(operator string, values ...string) InclusionFilter {
+	return InclusionFilter{Operand: OperandLocation, Operator: operator, Values: values}
+}
+
+var routes = []Route{
+	{
+		ID:   "r1",
+		Name: "Platform logs",
+		Rules: []Rule{
+			{Action: ActionDrop, InclusionFilters: []InclusionFilter{location(OperatorIs, "eu-de")}},
+			{Targets: []string{"t1", "t2"}, InclusionFilters: []InclusionFilter{location(OperatorIn, "us-south", "us-east", "eu-de")}},
+			{Action: ActionSend, Targets: []string{"t3"}, InclusionFilters: []InclusionFilter{location(OperatorIs, "us-east")}},
+		},
+	},
+	{
+		ID:    "r2",
+		Name:  "Catch-all",
+		Rules: []Rule{{Action: ActionSend, Targets: []string{"t4"}}},
+	},
+}
+
+func TestEvaluate(t *testing.T) {
+	evaluations := Evaluate(routes, Event{OperandLocation: "us-east"})
+	assert.Equal(t, []Evaluation{
+		{RouteIndex: 0, RuleIndex: 1, Action: ActionSend, Targets: []string{"t1", "t2"}},
+		{RouteIndex: 1, RuleIndex: 0, Action: ActionSend, Targets: []string{"t4"}},
+	}, evaluations)
+
+	evaluations = Evaluate(routes, Event{OperandLocation: "eu-de"})
+	assert.Equal(t, Evaluation{RouteIndex: 0, RuleIndex: 0, Action: ActionDrop}, evaluations[0])
+
+	evaluations = Evaluate(routes, Event{OperandLocation: "jp-tok"})
+	assert.False(t, evaluations[0].Matched())
+	assert.Equal(t, -1, evaluations[0].RuleIndex)
+	assert.True(t, evaluations[1].Matched())
+
+	evaluations = Evaluate(routes[:1], Event{OperandServiceName: "cloud-object-storage"})
+	assert.False(t, evaluations[0].Matched())
+}
+
+func TestInclusionFilterMatches(t *testing.T) {
+	event := Event{OperandLocation: "us-south", OperandServiceName: "cloud-object-storage"}
+	assert.True(t, location(OperatorIs, "us-south").Matches(event))
+	assert.False(t, location(OperatorIs, "us-south", "us-east").Matches(event))
+	assert.True(t, location(OperatorIn, "us-east", "us-south").Matches(event))
+	assert.False(t, location(OperatorIn).Matches(event))
+	assert.False(t, location("contains", "us-south").Matches(event))
+
+	rule := Rule{InclusionFilters: []InclusionFilter{
+		location(OperatorIn, "us-south", "us-east"),
+		{Operand: OperandServiceName, Operator: OperatorIs, Values: []string{"cloud-object-storage"}},
+	}}
+	assert.True(t, rule.Matches(event))
+	assert.False(t, rule.Matches(Event{OperandLocation: "us-south"}))
+}
+
+func TestFindUnreachableRules(t *testing.T) {
+	assert.Equal(t, []UnreachableRule{
+		{RouteIndex: 0, RuleIndex: 2, Reason: ReasonShadowed, ShadowedBy: 1},
+	}, FindUnreachableRules(routes))
+
+	rules := []Route{{Rules: []Rule{
+		{InclusionFilters: []InclusionFilter{location(OperatorIn, "us-south", "us-east")}},
+		{InclusionFilters: []InclusionFilter{location(OperatorIs, "us-south"), location(OperatorIs, "us-east")}},
+		{InclusionFilters: []InclusionFilter{location(OperatorIn, "us-south", "eu-de"), location(OperatorIn, "us-south", "jp-tok")}},
+		{InclusionFilters: []InclusionFilter{location(OperatorIn, "eu-de", "jp-tok"), {Operand: OperandServiceName, Operator: OperatorIs, Values: []string{"is"}}}},
+		{InclusionFilters: []InclusionFilter{location(OperatorIs, "eu-de")}},
+		{InclusionFilters: []InclusionFilter{location(OperatorIs, "jp-tok"), {Operand: OperandServiceName, Operator: OperatorIs, Values: []string{"is"}}}},
+		{},
+		{InclusionFilters: []InclusionFilter{location(OperatorIs, "br-sao")}},
+	}}}
+	assert.Equal(t, []UnreachableRule{
+		{RouteIndex: 0, RuleIndex: 1, Reason: ReasonNeverMatches, ShadowedBy: -1},
+		{RouteIndex: 0, RuleIndex: 2, Reason: ReasonShadowed, ShadowedBy: 0},
+		{RouteIndex: 0, RuleIndex: 5, Reason: ReasonShadowed, ShadowedBy: 3},
+		{RouteIndex: 0, RuleIndex: 7, Reason: ReasonShadowed, ShadowedBy: 6},
+	}, FindUnreachableRules(rules))
+
+	assert.Empty(t, FindUnreachableRules(routes[1:]))
+}
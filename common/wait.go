@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default values of the Backoff of the Wait methods of the services.
+const (
+	DefaultWaitInterval    = 5 * time.Second
+	DefaultWaitMaxInterval = 1 * time.Minute
+	DefaultWaitMultiplier  = 1.5
+)
+
+// Backoff : The exponentially increasing delay between the polls of a wait.
+type Backoff struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	multiplier  float64
+}
+
+// NewBackoff returns a Backoff whose first delay is "interval", multiplied by "multiplier" after each poll up to
+// "maxInterval". A zero or negative duration, or a multiplier lower than 1, selects the corresponding default.
+func NewBackoff(interval time.Duration, maxInterval time.Duration, multiplier float64) *Backoff {
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+	if multiplier < 1 {
+		multiplier = DefaultWaitMultiplier
+	}
+	return &Backoff{interval: interval, maxInterval: maxInterval, multiplier: multiplier}
+}
+
+// Next returns the delay before the next poll, and increases the delay before the following one.
+func (backoff *Backoff) Next() time.Duration {
+	delay := backoff.interval
+	backoff.interval = time.Duration(float64(backoff.interval) * backoff.multiplier)
+	if backoff.interval > backoff.maxInterval {
+		backoff.interval = backoff.maxInterval
+	}
+	return delay
+}
+
+// SleepContext waits for "delay" between two polls of a wait for "subject". It returns the error of WaitInterrupted
+// if the Context is done first.
+func SleepContext(ctx context.Context, delay time.Duration, subject string) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return WaitInterrupted(ctx, subject)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitInterrupted returns the error of a wait for "subject" whose Context is done: "wait-timeout" if the deadline
+// of the Context expired, and "wait-cancelled" if the Context was cancelled.
+func WaitInterrupted(ctx context.Context, subject string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return core.SDKErrorf(ctx.Err(), "timed out waiting for "+subject, "wait-timeout", GetComponentInfo())
+	}
+	return core.SDKErrorf(ctx.Err(), "cancelled while waiting for "+subject, "wait-cancelled", GetComponentInfo())
+}
@@ -0,0 +1,53 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	backoff := NewBackoff(time.Second, 3*time.Second, 2)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+		[]time.Duration{backoff.Next(), backoff.Next(), backoff.Next(), backoff.Next()})
+
+	backoff = NewBackoff(0, 0, 0.5)
+	assert.Equal(t, DefaultWaitInterval, backoff.Next())
+	assert.Equal(t, time.Duration(float64(DefaultWaitInterval)*DefaultWaitMultiplier), backoff.Next())
+	for i := 0; i < 20; i++ {
+		backoff.Next()
+	}
+	assert.Equal(t, DefaultWaitMaxInterval, backoff.Next())
+}
+
+func TestSleepContext(t *testing.T) {
+	assert.Nil(t, SleepContext(context.Background(), time.Millisecond, "x"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.EqualError(t, SleepContext(ctx, time.Hour, "resource 'x'"), "cancelled while waiting for resource 'x'")
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err := SleepContext(ctx, time.Hour, "resource 'x'")
+	assert.EqualError(t, err, "timed out waiting for resource 'x'")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
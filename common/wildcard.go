@@ -0,0 +1,49 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package common
+
+// WildcardMatch returns true if "s" matches "pattern", in which "*" matches any sequence of characters,
+// including "/" and ":", and "?" matches any single character. The other characters of "pattern" match
+// themselves.
+func WildcardMatch(pattern string, s string) bool {
+	p, v := []rune(pattern), []rune(s)
+	pi, vi := 0, 0
+
+	// The position of the last "*" of the pattern, and the position in "s" from which it matches.
+	star, mark := -1, 0
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, vi
+			pi++
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case star >= 0:
+			// Let the last "*" match one more character.
+			mark++
+			pi, vi = star+1, mark
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
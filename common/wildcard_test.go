@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWildcardMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		s       string
+		matches bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "a/b:c", true},
+		{"kms*", "kms", true},
+		{"kms*", "kms-prod", true},
+		{"kms*", "kmx", false},
+		{"a/*", "a/123", true},
+		{"*/123", "a/123", true},
+		{"reports/20??/*", "reports/2025/q1/summary.csv", true},
+		{"reports/20??/*", "reports/25/summary.csv", false},
+		{"*.csv", "a.csv.bak", false},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"**", "abc", true},
+		{"é?", "éé", true},
+		{"[a]", "[a]", true},
+	} {
+		assert.Equal(t, tc.matches, WildcardMatch(tc.pattern, tc.s), "%q %q", tc.pattern, tc.s)
+	}
+}
@@ -0,0 +1,371 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// IPRange : An inclusive range of IP addresses of the same family (IPv4 or IPv6). A single IP address and a subnet
+// are also ranges.
+type IPRange struct {
+	First netip.Addr
+	Last  netip.Addr
+}
+
+// ParseIPAddress parses the value of an address of type "ipAddress". IPv4-mapped IPv6 addresses are converted to
+// IPv4 addresses, and addresses with a zone, such as "fe80::1%eth0", are rejected.
+func ParseIPAddress(value string) (addr netip.Addr, err error) {
+	addr, err = netip.ParseAddr(strings.TrimSpace(value))
+	if err != nil || addr.Zone() != "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid IP address '%s'", value), "invalid-ip-address", common.GetComponentInfo())
+		return
+	}
+	addr = addr.Unmap()
+	return
+}
+
+// ParseSubnet parses the value of an address of type "subnet", in CIDR notation. The host bits of the subnet, as
+// in "10.1.2.3/16", are cleared.
+func ParseSubnet(value string) (prefix netip.Prefix, err error) {
+	prefix, err = netip.ParsePrefix(strings.TrimSpace(value))
+	if err != nil || prefix.Addr().Is4In6() {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid subnet '%s'", value), "invalid-subnet", common.GetComponentInfo())
+		return
+	}
+	prefix = prefix.Masked()
+	return
+}
+
+// ParseIPRange parses the value of an address of type "ipRange", in the form "first-last".
+func ParseIPRange(value string) (result IPRange, err error) {
+	first, last, found := strings.Cut(value, "-")
+	if found {
+		result.First, err = ParseIPAddress(first)
+		if err == nil {
+			result.Last, err = ParseIPAddress(last)
+		}
+	}
+	if !found || err != nil || result.First.BitLen() != result.Last.BitLen() || result.Last.Less(result.First) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid IP address range '%s'", value), "invalid-ip-range", common.GetComponentInfo())
+	}
+	return
+}
+
+// ParseAddress returns the IP addresses of an address of type "ipAddress", "ipRange" or "subnet". An error is
+// returned if the address is malformed or of another type.
+func ParseAddress(address AddressIntf) (result IPRange, err error) {
+	addressType, value, _ := addressFields(address)
+	switch addressType {
+	case AddressTypeIpaddressConst:
+		var addr netip.Addr
+		if addr, err = ParseIPAddress(value); err == nil {
+			result = IPRange{First: addr, Last: addr}
+		}
+	case AddressTypeIprangeConst:
+		result, err = ParseIPRange(value)
+	case AddressTypeSubnetConst:
+		var prefix netip.Prefix
+		if prefix, err = ParseSubnet(value); err == nil {
+			result = IPRangeFromPrefix(prefix)
+		}
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("the address of type '%s' is not an IP address", addressType), "not-ip-address", common.GetComponentInfo())
+	}
+	return
+}
+
+// addressFields returns the type, value and service reference of "address", whatever its concrete type.
+func addressFields(address AddressIntf) (addressType string, value string, ref *ServiceRefValue) {
+	switch address := address.(type) {
+	case *Address:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), address.Ref
+	case *AddressIPAddress:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressIPAddressRange:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressSubnet:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressVPC:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressServiceRef:
+		return core.StringNilMapper(address.Type), "", address.Ref
+	}
+	return fmt.Sprintf("%T", address), "", nil
+}
+
+// IPRangeFromPrefix returns the range of the IP addresses of "prefix".
+func IPRangeFromPrefix(prefix netip.Prefix) IPRange {
+	prefix = prefix.Masked()
+	return IPRange{First: prefix.Addr(), Last: lastAddr(prefix)}
+}
+
+// lastAddr returns the last IP address of "prefix", whose host bits are cleared.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+// Contains returns true if "addr" is in the range.
+func (r IPRange) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.BitLen() == r.First.BitLen() && !addr.Less(r.First) && !r.Last.Less(addr)
+}
+
+// Prefixes returns the smallest list of subnets that hold exactly the IP addresses of the range.
+func (r IPRange) Prefixes() (prefixes []netip.Prefix) {
+	first := r.First
+	for {
+		bits := first.BitLen()
+		for bits > 0 {
+			prefix, _ := first.Prefix(bits - 1)
+			if prefix.Addr() != first || r.Last.Less(lastAddr(prefix)) {
+				break
+			}
+			bits--
+		}
+		prefix := netip.PrefixFrom(first, bits)
+		prefixes = append(prefixes, prefix)
+		last := lastAddr(prefix)
+		if last == r.Last {
+			return
+		}
+		first = last.Next()
+	}
+}
+
+// String returns the range as an IP address if it holds a single IP address, as a subnet in CIDR notation if it
+// is a subnet, and in the form "first-last" otherwise.
+func (r IPRange) String() string {
+	if r.First == r.Last {
+		return r.First.String()
+	}
+	if prefixes := r.Prefixes(); len(prefixes) == 1 {
+		return prefixes[0].String()
+	}
+	return r.First.String() + "-" + r.Last.String()
+}
+
+// Address returns the range as an address of a zone, of type "ipAddress", "subnet" or "ipRange" as for String.
+func (r IPRange) Address() AddressIntf {
+	value := r.String()
+	switch {
+	case r.First == r.Last:
+		return &AddressIPAddress{Type: core.StringPtr(AddressIPAddressTypeIpaddressConst), Value: &value}
+	case !strings.Contains(value, "-"):
+		return &AddressSubnet{Type: core.StringPtr(AddressSubnetTypeSubnetConst), Value: &value}
+	}
+	return &AddressIPAddressRange{Type: core.StringPtr(AddressIPAddressRangeTypeIprangeConst), Value: &value}
+}
+
+// IPSet : A set of IP addresses, held as sorted ranges that neither overlap nor are adjacent. The IPv4 ranges
+// precede the IPv6 ranges. The zero value is an empty set.
+type IPSet struct {
+	ranges []IPRange
+}
+
+// NewIPSet returns the set of the IP addresses of "ranges", which are merged if they overlap or are adjacent.
+func NewIPSet(ranges ...IPRange) *IPSet {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b IPRange) int {
+		return a.First.Compare(b.First)
+	})
+	set := &IPSet{}
+	for _, r := range sorted {
+		if n := len(set.ranges); n > 0 {
+			previous := &set.ranges[n-1]
+			next := previous.Last.Next()
+			if previous.Last.BitLen() == r.First.BitLen() && (!next.IsValid() || !next.Less(r.First)) {
+				if previous.Last.Less(r.Last) {
+					previous.Last = r.Last
+				}
+				continue
+			}
+		}
+		set.ranges = append(set.ranges, r)
+	}
+	return set
+}
+
+// Ranges returns the ranges of the set.
+func (set *IPSet) Ranges() []IPRange {
+	return slices.Clone(set.ranges)
+}
+
+// IsEmpty returns true if the set holds no IP address.
+func (set *IPSet) IsEmpty() bool {
+	return len(set.ranges) == 0
+}
+
+// Contains returns true if "addr" is in the set.
+func (set *IPSet) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	i, _ := slices.BinarySearchFunc(set.ranges, addr, func(r IPRange, addr netip.Addr) int {
+		return r.Last.Compare(addr)
+	})
+	return i < len(set.ranges) && set.ranges[i].Contains(addr)
+}
+
+// Equal returns true if the sets hold the same IP addresses.
+func (set *IPSet) Equal(other *IPSet) bool {
+	return slices.Equal(set.ranges, other.ranges)
+}
+
+// Union returns the set of the IP addresses that are in the set or in "other".
+func (set *IPSet) Union(other *IPSet) *IPSet {
+	return NewIPSet(append(slices.Clone(set.ranges), other.ranges...)...)
+}
+
+// Subtract returns the set of the IP addresses that are in the set but not in "other".
+func (set *IPSet) Subtract(other *IPSet) *IPSet {
+	result := &IPSet{}
+	for _, r := range set.ranges {
+		remaining := true
+		for _, excluded := range other.ranges {
+			if excluded.First.BitLen() != r.First.BitLen() || excluded.Last.Less(r.First) || r.Last.Less(excluded.First) {
+				continue
+			}
+			if r.First.Less(excluded.First) {
+				result.ranges = append(result.ranges, IPRange{First: r.First, Last: excluded.First.Prev()})
+			}
+			if !excluded.Last.Less(r.Last) {
+				remaining = false
+				break
+			}
+			r.First = excluded.Last.Next()
+		}
+		if remaining {
+			result.ranges = append(result.ranges, r)
+		}
+	}
+	return result
+}
+
+// Addresses returns the set as addresses of a zone, one per range (see IPRange.Address).
+func (set *IPSet) Addresses() []AddressIntf {
+	addresses := make([]AddressIntf, len(set.ranges))
+	for i, r := range set.ranges {
+		addresses[i] = r.Address()
+	}
+	return addresses
+}
+
+// ZoneAddresses : The effective addresses of a zone: its IP addresses without the excluded ones, its VPCs and its
+// service references, without duplicates.
+type ZoneAddresses struct {
+	IPs         *IPSet
+	VPCs        []string
+	ServiceRefs []ServiceRefValue
+}
+
+// NewZoneAddresses returns the effective addresses of a zone with "addresses" and "excluded" addresses. An error
+// is returned if an address is malformed, or if an excluded address is not of type "ipAddress", "ipRange" or
+// "subnet".
+func NewZoneAddresses(addresses []AddressIntf, excluded []AddressIntf) (*ZoneAddresses, error) {
+	result := &ZoneAddresses{}
+	var ranges []IPRange
+	for _, address := range addresses {
+		addressType, value, ref := addressFields(address)
+		switch addressType {
+		case AddressTypeVPCConst:
+			if !slices.Contains(result.VPCs, value) {
+				result.VPCs = append(result.VPCs, value)
+			}
+		case AddressTypeServicerefConst:
+			if ref != nil && !slices.ContainsFunc(result.ServiceRefs, func(other ServiceRefValue) bool { return reflect.DeepEqual(&other, ref) }) {
+				result.ServiceRefs = append(result.ServiceRefs, *ref)
+			}
+		default:
+			r, err := ParseAddress(address)
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, r)
+		}
+	}
+	var excludedRanges []IPRange
+	for _, address := range excluded {
+		r, err := ParseAddress(address)
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "invalid-excluded-address")
+		}
+		excludedRanges = append(excludedRanges, r)
+	}
+	result.IPs = NewIPSet(ranges...).Subtract(NewIPSet(excludedRanges...))
+	return result, nil
+}
+
+// EffectiveAddresses returns the effective addresses of the zone.
+func (_model *Zone) EffectiveAddresses() (*ZoneAddresses, error) {
+	return NewZoneAddresses(_model.Addresses, _model.Excluded)
+}
+
+// Addresses returns the effective addresses as the addresses of a zone without excluded addresses: the IP
+// addresses (see IPSet.Addresses), followed by the VPCs and the service references.
+func (addresses *ZoneAddresses) Addresses() []AddressIntf {
+	result := addresses.IPs.Addresses()
+	for _, vpc := range addresses.VPCs {
+		result = append(result, &AddressVPC{Type: core.StringPtr(AddressVPCTypeVPCConst), Value: core.StringPtr(vpc)})
+	}
+	for _, ref := range addresses.ServiceRefs {
+		result = append(result, &AddressServiceRef{Type: core.StringPtr(AddressServiceRefTypeServicerefConst), Ref: &ref})
+	}
+	return result
+}
+
+// Split returns zones with at most "maxAddresses" addresses each, and no excluded addresses, which together hold
+// the effective addresses of the zone, to respect the limit of the service on the number of addresses of a zone.
+// The zones have the account ID and description of the zone. If a single zone is enough, it has the name of the
+// zone; otherwise the zones are named "<name>-1", "<name>-2" and so on, and the rules that reference the zone must
+// reference all of them. The zones have no ID and must be created.
+func (_model *Zone) Split(maxAddresses int) ([]*Zone, error) {
+	if maxAddresses <= 0 {
+		return nil, core.SDKErrorf(nil, "the maximum number of addresses must be positive", "split-invalid-max", common.GetComponentInfo())
+	}
+	effective, err := _model.EffectiveAddresses()
+	if err != nil {
+		return nil, err
+	}
+	addresses := effective.Addresses()
+	var zones []*Zone
+	for start := 0; start < len(addresses) || start == 0; start += maxAddresses {
+		zones = append(zones, &Zone{
+			Name:        _model.Name,
+			AccountID:   _model.AccountID,
+			Description: _model.Description,
+			Addresses:   addresses[start:min(start+maxAddresses, len(addresses))],
+			Excluded:    []AddressIntf{},
+		})
+	}
+	if len(zones) > 1 {
+		for i, zone := range zones {
+			zone.Name = core.StringPtr(fmt.Sprintf("%s-%d", core.StringNilMapper(_model.Name), i+1))
+		}
+	}
+	return zones, nil
+}
@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"net/netip"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Zone addresses`, func() {
+	address := func(addressType string, value string) contextbasedrestrictionsv1.AddressIntf {
+		return &contextbasedrestrictionsv1.Address{Type: core.StringPtr(addressType), Value: core.StringPtr(value)}
+	}
+	ipRange := func(value string) contextbasedrestrictionsv1.IPRange {
+		r, err := contextbasedrestrictionsv1.ParseIPRange(value)
+		Expect(err).To(BeNil())
+		return r
+	}
+	rangeStrings := func(set *contextbasedrestrictionsv1.IPSet) (result []string) {
+		for _, r := range set.Ranges() {
+			result = append(result, r.String())
+		}
+		return
+	}
+	addressValues := func(addresses []contextbasedrestrictionsv1.AddressIntf) (result []string) {
+		for _, a := range addresses {
+			switch a := a.(type) {
+			case *contextbasedrestrictionsv1.AddressIPAddress:
+				result = append(result, *a.Type+" "+*a.Value)
+			case *contextbasedrestrictionsv1.AddressSubnet:
+				result = append(result, *a.Type+" "+*a.Value)
+			case *contextbasedrestrictionsv1.AddressIPAddressRange:
+				result = append(result, *a.Type+" "+*a.Value)
+			case *contextbasedrestrictionsv1.AddressVPC:
+				result = append(result, *a.Type+" "+*a.Value)
+			case *contextbasedrestrictionsv1.AddressServiceRef:
+				result = append(result, *a.Type+" "+*a.Ref.ServiceName)
+			}
+		}
+		return
+	}
+
+	Describe(`ParseAddress`, func() {
+		It(`Parses and validates IPv4 and IPv6 addresses, ranges and subnets`, func() {
+			for value, expected := range map[[2]string]string{
+				{"ipAddress", "10.0.0.1"}:                    "10.0.0.1",
+				{"ipAddress", "::ffff:10.0.0.1"}:             "10.0.0.1",
+				{"ipAddress", "2001:DB8:0::1"}:               "2001:db8::1",
+				{"subnet", "10.1.2.3/16"}:                    "10.1.0.0/16",
+				{"subnet", "10.0.0.1/32"}:                    "10.0.0.1",
+				{"subnet", "0.0.0.0/0"}:                      "0.0.0.0/0",
+				{"ipRange", "10.0.0.0-10.0.0.127"}:           "10.0.0.0/25",
+				{"ipRange", "10.0.0.1-10.0.0.1"}:             "10.0.0.1",
+				{"ipRange", "10.0.0.1-10.0.0.2"}:             "10.0.0.1-10.0.0.2",
+				{"ipRange", "2001:db8::-2001:db8::ffff"}:     "2001:db8::/112",
+				{"ipRange", "2001:db8::1-2001:db8::1:0:0:1"}: "2001:db8::1-2001:db8::1:0:0:1",
+			} {
+				r, err := contextbasedrestrictionsv1.ParseAddress(address(value[0], value[1]))
+				Expect(err).To(BeNil(), value[1])
+				Expect(r.String()).To(Equal(expected), value[1])
+			}
+
+			r, err := contextbasedrestrictionsv1.ParseAddress(&contextbasedrestrictionsv1.AddressSubnet{Type: core.StringPtr("subnet"), Value: core.StringPtr("192.168.0.0/16")})
+			Expect(err).To(BeNil())
+			Expect(r).To(Equal(contextbasedrestrictionsv1.IPRange{First: netip.MustParseAddr("192.168.0.0"), Last: netip.MustParseAddr("192.168.255.255")}))
+			Expect(r.Contains(netip.MustParseAddr("192.168.3.4"))).To(BeTrue())
+			Expect(r.Contains(netip.MustParseAddr("::ffff:192.168.3.4"))).To(BeTrue())
+			Expect(r.Contains(netip.MustParseAddr("192.169.0.0"))).To(BeFalse())
+		})
+		It(`Returns an error for malformed addresses and addresses of other types`, func() {
+			for value, message := range map[[2]string]string{
+				{"ipAddress", "10.0.0.256"}:           "invalid IP address '10.0.0.256'",
+				{"ipAddress", "fe80::1%eth0"}:         "invalid IP address 'fe80::1%eth0'",
+				{"subnet", "10.0.0.0/33"}:             "invalid subnet '10.0.0.0/33'",
+				{"subnet", "10.0.0.0"}:                "invalid subnet '10.0.0.0'",
+				{"ipRange", "10.0.0.2-10.0.0.1"}:      "invalid IP address range '10.0.0.2-10.0.0.1'",
+				{"ipRange", "10.0.0.1-2001:db8::1"}:   "invalid IP address range '10.0.0.1-2001:db8::1'",
+				{"ipRange", "10.0.0.1"}:               "invalid IP address range '10.0.0.1'",
+				{"vpc", "crn:v1:bluemix:public:is::"}: "the address of type 'vpc' is not an IP address",
+			} {
+				_, err := contextbasedrestrictionsv1.ParseAddress(address(value[0], value[1]))
+				Expect(err).To(MatchError(message))
+			}
+		})
+	})
+
+	Describe(`IPRange`, func() {
+		It(`Returns the subnets of a range`, func() {
+			Expect(ipRange("10.0.0.1-10.0.0.10").Prefixes()).To(Equal([]netip.Prefix{
+				netip.MustParsePrefix("10.0.0.1/32"),
+				netip.MustParsePrefix("10.0.0.2/31"),
+				netip.MustParsePrefix("10.0.0.4/30"),
+				netip.MustParsePrefix("10.0.0.8/31"),
+				netip.MustParsePrefix("10.0.0.10/32"),
+			}))
+			Expect(ipRange("0.0.0.0-255.255.255.255").Prefixes()).To(Equal([]netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}))
+		})
+		It(`Converts a range to an address of the right type`, func() {
+			Expect(addressValues([]contextbasedrestrictionsv1.AddressIntf{
+				ipRange("10.0.0.1-10.0.0.1").Address(),
+				ipRange("10.0.0.0-10.0.0.255").Address(),
+				ipRange("10.0.0.1-10.0.0.255").Address(),
+			})).To(Equal([]string{"ipAddress 10.0.0.1", "subnet 10.0.0.0/24", "ipRange 10.0.0.1-10.0.0.255"}))
+		})
+	})
+
+	Describe(`IPSet`, func() {
+		It(`Merges overlapping and adjacent ranges`, func() {
+			set := contextbasedrestrictionsv1.NewIPSet(
+				ipRange("2001:db8::1-2001:db8::5"),
+				ipRange("10.0.1.0-10.0.1.255"),
+				ipRange("10.0.0.0-10.0.0.255"),
+				ipRange("10.0.0.128-10.0.0.200"),
+				ipRange("10.0.3.0-10.0.3.9"),
+				ipRange("255.255.255.0-255.255.255.255"),
+				ipRange("255.255.255.250-255.255.255.255"),
+				ipRange("2001:db8::4-2001:db8::9"),
+			)
+			Expect(rangeStrings(set)).To(Equal([]string{"10.0.0.0/23", "10.0.3.0-10.0.3.9", "255.255.255.0/24", "2001:db8::1-2001:db8::9"}))
+			Expect(set.Contains(netip.MustParseAddr("10.0.1.7"))).To(BeTrue())
+			Expect(set.Contains(netip.MustParseAddr("10.0.2.7"))).To(BeFalse())
+			Expect(set.Contains(netip.MustParseAddr("2001:db8::9"))).To(BeTrue())
+			Expect(set.Contains(netip.MustParseAddr("2001:db8::a"))).To(BeFalse())
+			Expect(set.Equal(contextbasedrestrictionsv1.NewIPSet(set.Ranges()...))).To(BeTrue())
+			Expect(set.Union(contextbasedrestrictionsv1.NewIPSet(ipRange("10.0.2.0-10.0.2.255"))).Ranges()[0].String()).To(Equal("10.0.0.0-10.0.3.9"))
+			Expect((&contextbasedrestrictionsv1.IPSet{}).IsEmpty()).To(BeTrue())
+		})
+		It(`Subtracts ranges`, func() {
+			set := contextbasedrestrictionsv1.NewIPSet(ipRange("10.0.0.0-10.0.0.255"), ipRange("10.0.2.0-10.0.2.255"), ipRange("2001:db8::-2001:db8::ff"))
+			excluded := contextbasedrestrictionsv1.NewIPSet(
+				ipRange("9.0.0.0-10.0.0.0"),
+				ipRange("10.0.0.10-10.0.0.19"),
+				ipRange("10.0.0.250-10.0.2.5"),
+				ipRange("2001:db8::-2001:db8::ff"),
+			)
+			Expect(rangeStrings(set.Subtract(excluded))).To(Equal([]string{"10.0.0.1-10.0.0.9", "10.0.0.20-10.0.0.249", "10.0.2.6-10.0.2.255"}))
+			Expect(set.Subtract(set).IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Describe(`Zone`, func() {
+		zone := func() *contextbasedrestrictionsv1.Zone {
+			return &contextbasedrestrictionsv1.Zone{
+				Name:        core.StringPtr("office"),
+				AccountID:   core.StringPtr("12ab34cd56ef78ab90cd12ef34ab56cd"),
+				Description: core.StringPtr("Office network"),
+				Addresses: []contextbasedrestrictionsv1.AddressIntf{
+					address("subnet", "10.0.0.0/24"),
+					address("ipRange", "10.0.0.128-10.0.1.255"),
+					address("ipAddress", "10.0.5.1"),
+					address("ipAddress", "2001:db8::1"),
+					address("vpc", "crn:vpc"),
+					address("vpc", "crn:vpc"),
+					&contextbasedrestrictionsv1.AddressServiceRef{Type: core.StringPtr("serviceRef"), Ref: &contextbasedrestrictionsv1.ServiceRefValue{ServiceName: core.StringPtr("containers-kubernetes")}},
+				},
+				Excluded: []contextbasedrestrictionsv1.AddressIntf{address("subnet", "10.0.1.0/24"), address("ipAddress", "10.0.5.1")},
+			}
+		}
+		It(`Computes the effective addresses`, func() {
+			effective, err := zone().EffectiveAddresses()
+			Expect(err).To(BeNil())
+			Expect(rangeStrings(effective.IPs)).To(Equal([]string{"10.0.0.0/24", "2001:db8::1"}))
+			Expect(effective.VPCs).To(Equal([]string{"crn:vpc"}))
+			Expect(effective.ServiceRefs).To(HaveLen(1))
+			Expect(addressValues(effective.Addresses())).To(Equal([]string{"subnet 10.0.0.0/24", "ipAddress 2001:db8::1", "vpc crn:vpc", "serviceRef containers-kubernetes"}))
+
+			z := zone()
+			z.Excluded = append(z.Excluded, address("vpc", "crn:vpc"))
+			_, err = z.EffectiveAddresses()
+			Expect(err).To(MatchError("the address of type 'vpc' is not an IP address"))
+		})
+		It(`Splits a zone`, func() {
+			zones, err := zone().Split(3)
+			Expect(err).To(BeNil())
+			Expect(zones).To(HaveLen(2))
+			Expect(*zones[0].Name).To(Equal("office-1"))
+			Expect(*zones[1].Name).To(Equal("office-2"))
+			Expect(*zones[1].Description).To(Equal("Office network"))
+			Expect(addressValues(zones[0].Addresses)).To(Equal([]string{"subnet 10.0.0.0/24", "ipAddress 2001:db8::1", "vpc crn:vpc"}))
+			Expect(addressValues(zones[1].Addresses)).To(Equal([]string{"serviceRef containers-kubernetes"}))
+			Expect(zones[0].Excluded).To(BeEmpty())
+
+			zones, err = zone().Split(4)
+			Expect(err).To(BeNil())
+			Expect(zones).To(HaveLen(1))
+			Expect(*zones[0].Name).To(Equal("office"))
+
+			_, err = zone().Split(0)
+			Expect(err).To(MatchError("the maximum number of addresses must be positive"))
+		})
+	})
+})
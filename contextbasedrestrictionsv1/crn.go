@@ -0,0 +1,41 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/common/crn"
+)
+
+// ParseCRN returns the parsed form of the CRN field of the Zone.
+func (_model *Zone) ParseCRN() (result crn.CRN, err error) {
+	result, err = crn.ParsePtr(_model.CRN)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "crn-parse-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ParseCRN returns the parsed form of the CRN field of the Rule.
+func (_model *Rule) ParseCRN() (result crn.CRN, err error) {
+	result, err = crn.ParsePtr(_model.CRN)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "crn-parse-error", common.GetComponentInfo())
+	}
+	return
+}
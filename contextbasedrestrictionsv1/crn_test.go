@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN parsing`, func() {
+	unmarshal := func(s string) map[string]json.RawMessage {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(s), &m)).To(BeNil())
+		return m
+	}
+
+	It(`Invoke Zone.ParseCRN() successfully`, func() {
+		var model *contextbasedrestrictionsv1.Zone
+		Expect(contextbasedrestrictionsv1.UnmarshalZone(unmarshal(`{"crn": "crn:v1:bluemix:public:context-based-restrictions::a/59bcbfa6ea2f006b4ed7094c1a08dcdd::zone:65810ac762004f22ac19f8f8edf70a34"}`), &model)).To(BeNil())
+		result, err := model.ParseCRN()
+		Expect(err).To(BeNil())
+		Expect(result.ServiceName).To(Equal("context-based-restrictions"))
+		Expect(result.AccountID()).To(Equal("59bcbfa6ea2f006b4ed7094c1a08dcdd"))
+		Expect(result.String()).To(Equal("crn:v1:bluemix:public:context-based-restrictions::a/59bcbfa6ea2f006b4ed7094c1a08dcdd::zone:65810ac762004f22ac19f8f8edf70a34"))
+
+		model.CRN = core.StringPtr("not-a-crn")
+		_, err = model.ParseCRN()
+		Expect(err).ToNot(BeNil())
+		model.CRN = nil
+		_, err = model.ParseCRN()
+		Expect(err).To(MatchError("CRN is not set"))
+	})
+
+	It(`Invoke Rule.ParseCRN() successfully`, func() {
+		var model *contextbasedrestrictionsv1.Rule
+		Expect(contextbasedrestrictionsv1.UnmarshalRule(unmarshal(`{"crn": "crn:v1:bluemix:public:context-based-restrictions::a/59bcbfa6ea2f006b4ed7094c1a08dcdd::rule:1a2b3c"}`), &model)).To(BeNil())
+		result, err := model.ParseCRN()
+		Expect(err).To(BeNil())
+		Expect(result.ServiceName).To(Equal("context-based-restrictions"))
+		Expect(result.AccountID()).To(Equal("59bcbfa6ea2f006b4ed7094c1a08dcdd"))
+		Expect(result.String()).To(Equal("crn:v1:bluemix:public:context-based-restrictions::a/59bcbfa6ea2f006b4ed7094c1a08dcdd::rule:1a2b3c"))
+
+		model.CRN = core.StringPtr("not-a-crn")
+		_, err = model.ParseCRN()
+		Expect(err).ToNot(BeNil())
+		model.CRN = nil
+		_, err = model.ParseCRN()
+		Expect(err).To(MatchError("CRN is not set"))
+	})
+})
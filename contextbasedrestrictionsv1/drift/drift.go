@@ -0,0 +1,585 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package drift detects the differences between the desired context-based restrictions zones and rules of an
+// account, typically kept in a version control system, and the zones and rules actually configured:
+//
+//	spec, err := drift.LoadSpec(file)
+//	detector, err := drift.New(contextBasedRestrictionsService, accountID)
+//	report, err := detector.Detect(ctx, spec)
+//	err = report.Write(os.Stdout)
+//	replaced, err := detector.Apply(ctx, report)
+//
+// The comparison is semantic: the contexts, resources and operations of a rule are compared as unordered sets, and
+// the addresses of a zone are compared as its effective addresses (see contextbasedrestrictionsv1.ZoneAddresses),
+// so that for example the subnets "10.0.0.0/25" and "10.0.0.128/25" are equal to the range "10.0.0.0-10.0.0.255",
+// and the IP addresses that differ are reported as ranges, whatever the excluded addresses they come from. The
+// zones are identified by name. The rules are identified by ID if the desired rule has one, and by description
+// otherwise. The "networkZoneId" context attributes of the desired rules may reference the zones by ID or by name.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"sigs.k8s.io/yaml"
+)
+
+// The kinds of drift.
+const (
+	// The resource is in the account but not in the desired spec.
+	DriftAdded = "added"
+
+	// The resource is in the desired spec but not in the account.
+	DriftDeleted = "deleted"
+
+	// The resource is in the account with fields that differ from the desired spec.
+	DriftChanged = "changed"
+)
+
+// The kinds of resources.
+const (
+	ResourceZone = "zone"
+	ResourceRule = "rule"
+)
+
+// Name of the context attribute that references zones.
+const contextAttributeNetworkZoneID = "networkZoneId"
+
+// Spec : The desired zones and rules of an account.
+type Spec struct {
+	Zones []contextbasedrestrictionsv1.Zone
+	Rules []contextbasedrestrictionsv1.Rule
+}
+
+// LoadSpec reads a Spec in the JSON or YAML format from "r". The spec is an object with a "zones" and a "rules"
+// list, whose elements have the fields of the zones and rules of the API, such as "name", "addresses" and
+// "excluded" for a zone. The fields generated by the service, such as "crn" or "created_at", are ignored.
+func LoadSpec(r io.Reader) (*Spec, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is a subset of YAML.
+	if data, err = yaml.YAMLToJSON(data); err != nil {
+		return nil, fmt.Errorf("error parsing the spec: %w", err)
+	}
+	var raw struct {
+		Zones []map[string]json.RawMessage `json:"zones"`
+		Rules []map[string]json.RawMessage `json:"rules"`
+	}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("error parsing the spec: %w", err)
+	}
+	spec := &Spec{}
+	for i, m := range raw.Zones {
+		var zone *contextbasedrestrictionsv1.Zone
+		if err = contextbasedrestrictionsv1.UnmarshalZone(m, &zone); err != nil {
+			return nil, fmt.Errorf("error parsing zone %d of the spec: %w", i, err)
+		}
+		spec.Zones = append(spec.Zones, *zone)
+	}
+	for i, m := range raw.Rules {
+		var rule *contextbasedrestrictionsv1.Rule
+		if err = contextbasedrestrictionsv1.UnmarshalRule(m, &rule); err != nil {
+			return nil, fmt.Errorf("error parsing rule %d of the spec: %w", i, err)
+		}
+		spec.Rules = append(spec.Rules, *rule)
+	}
+	return spec, nil
+}
+
+// Detector : Detects and corrects the drift of the zones and rules of an account. It is created with New.
+type Detector struct {
+	service   *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	accountID string
+}
+
+// New returns a Detector for the zones and rules of the account identified by "accountID".
+func New(service *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, accountID string) (*Detector, error) {
+	if service == nil {
+		return nil, fmt.Errorf("the context-based restrictions service must be specified")
+	}
+	if accountID == "" {
+		return nil, fmt.Errorf("the account ID must be specified")
+	}
+	return &Detector{service: service, accountID: accountID}, nil
+}
+
+// Report : The drift of the zones and rules of an account.
+type Report struct {
+	// The drifts of the zones, then of the rules, in the order of the desired spec followed by the resources
+	// that are only in the account.
+	Drifts []Drift
+}
+
+// Drift : The difference between the desired and actual states of a zone or rule.
+type Drift struct {
+	// The kind of drift, such as DriftChanged.
+	Kind string
+
+	// The kind of resource: ResourceZone or ResourceRule.
+	Resource string
+
+	// The ID of the resource in the account. Empty for DriftDeleted.
+	ID string
+
+	// The name of a zone, or the description of a rule.
+	Name string
+
+	// The fields of a DriftChanged resource that differ.
+	Changes []Change
+
+	// The desired zone or rule. Nil for DriftAdded. The "networkZoneId" attributes of a desired rule reference
+	// the zones of the account by ID.
+	DesiredZone *contextbasedrestrictionsv1.Zone
+	DesiredRule *contextbasedrestrictionsv1.Rule
+
+	// The ETag of a DriftChanged resource when the drift was detected, used by Apply.
+	ETag string
+}
+
+// Change : A field of a zone or rule whose actual value differs from the desired value.
+type Change struct {
+	// The name of the field, such as "description" or "addresses".
+	Field string
+
+	// The desired and actual values of a single-valued field.
+	Desired string
+	Actual  string
+
+	// The values of a multi-valued field (the effective addresses of a zone, and the contexts, resources or
+	// operations of a rule) that are in the account but not in the desired spec, and conversely.
+	Added   []string
+	Removed []string
+}
+
+// String returns a description of the change: "field: actual -> desired" for a single-valued field, and
+// "field: +added, -removed" for a multi-valued field.
+func (change Change) String() string {
+	if change.Added == nil && change.Removed == nil {
+		return fmt.Sprintf("%s: %q -> %q", change.Field, change.Actual, change.Desired)
+	}
+	var values []string
+	for _, value := range change.Added {
+		values = append(values, "+"+value)
+	}
+	for _, value := range change.Removed {
+		values = append(values, "-"+value)
+	}
+	return fmt.Sprintf("%s: %s", change.Field, strings.Join(values, ", "))
+}
+
+var driftSymbols = map[string]string{
+	DriftAdded:   "+",
+	DriftDeleted: "-",
+	DriftChanged: "~",
+}
+
+// String returns a one line description of the drift.
+func (drift Drift) String() string {
+	description := fmt.Sprintf("%s %s '%s'", driftSymbols[drift.Kind], drift.Resource, drift.Name)
+	if drift.ID != "" {
+		description += fmt.Sprintf(" (%s)", drift.ID)
+	}
+	return description
+}
+
+// HasDrift returns true if the account differs from the desired spec.
+func (report *Report) HasDrift() bool {
+	return len(report.Drifts) > 0
+}
+
+// Write writes the drifts to "w", one per line, each followed by its changes.
+func (report *Report) Write(w io.Writer) error {
+	for _, drift := range report.Drifts {
+		if _, err := fmt.Fprintln(w, drift); err != nil {
+			return err
+		}
+		for _, change := range drift.Changes {
+			if _, err := fmt.Fprintf(w, "    %s\n", change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// actualZone : A zone of the account, with its ETag.
+type actualZone struct {
+	zone *contextbasedrestrictionsv1.Zone
+	etag string
+}
+
+// Detect compares the zones and rules of the account with "spec". An error is returned if the spec is invalid,
+// for example if two zones have the same name or if an address is malformed, or if the zones and rules of the
+// account cannot be retrieved.
+func (detector *Detector) Detect(ctx context.Context, spec *Spec) (*Report, error) {
+	zones, err := detector.listZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ruleList, _, err := detector.service.ListRulesWithContext(ctx, detector.service.NewListRulesOptions(detector.accountID))
+	if err != nil {
+		return nil, fmt.Errorf("error listing the rules: %w", err)
+	}
+
+	report := &Report{}
+	if err = detector.detectZones(spec, zones, report); err != nil {
+		return nil, err
+	}
+	zoneIDs := make(map[string]string)
+	for _, actual := range zones {
+		zoneIDs[core.StringNilMapper(actual.zone.Name)] = core.StringNilMapper(actual.zone.ID)
+	}
+	if err = detector.detectRules(ctx, spec, ruleList.Rules, zoneIDs, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// listZones returns the zones of the account, with their addresses, which are not returned by ListZones.
+func (detector *Detector) listZones(ctx context.Context) ([]actualZone, error) {
+	zoneList, _, err := detector.service.ListZonesWithContext(ctx, detector.service.NewListZonesOptions(detector.accountID))
+	if err != nil {
+		return nil, fmt.Errorf("error listing the zones: %w", err)
+	}
+	zones := make([]actualZone, 0, len(zoneList.Zones))
+	for _, summary := range zoneList.Zones {
+		id := core.StringNilMapper(summary.ID)
+		zone, response, err := detector.service.GetZoneWithContext(ctx, detector.service.NewGetZoneOptions(id))
+		if err != nil {
+			return nil, fmt.Errorf("error getting the zone '%s': %w", id, err)
+		}
+		zones = append(zones, actualZone{zone: zone, etag: response.GetHeaders().Get("ETag")})
+	}
+	return zones, nil
+}
+
+// detectZones appends the drifts of the zones to "report".
+func (detector *Detector) detectZones(spec *Spec, zones []actualZone, report *Report) error {
+	matched := make(map[string]bool)
+	for i := range spec.Zones {
+		desired := &spec.Zones[i]
+		name := core.StringNilMapper(desired.Name)
+		if name == "" {
+			return fmt.Errorf("zone %d of the spec has no name", i)
+		}
+		if matched[name] {
+			return fmt.Errorf("more than one zone of the spec is named '%s'", name)
+		}
+		matched[name] = true
+		desiredFields, err := zoneFields(desired)
+		if err != nil {
+			return fmt.Errorf("zone '%s' of the spec: %w", name, err)
+		}
+
+		index := slices.IndexFunc(zones, func(actual actualZone) bool { return core.StringNilMapper(actual.zone.Name) == name })
+		if index < 0 {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftDeleted, Resource: ResourceZone, Name: name, DesiredZone: desired})
+			continue
+		}
+		actual := zones[index]
+		actualFields, err := zoneFields(actual.zone)
+		if err != nil {
+			return fmt.Errorf("zone '%s' of the account: %w", name, err)
+		}
+		if changes := compare(desiredFields, actualFields); len(changes) > 0 {
+			report.Drifts = append(report.Drifts, Drift{
+				Kind:        DriftChanged,
+				Resource:    ResourceZone,
+				ID:          core.StringNilMapper(actual.zone.ID),
+				Name:        name,
+				Changes:     changes,
+				DesiredZone: desired,
+				ETag:        actual.etag,
+			})
+		}
+	}
+	for _, actual := range zones {
+		if name := core.StringNilMapper(actual.zone.Name); !matched[name] {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftAdded, Resource: ResourceZone, ID: core.StringNilMapper(actual.zone.ID), Name: name})
+		}
+	}
+	return nil
+}
+
+// detectRules appends the drifts of the rules to "report". The names of the zones referenced by the desired
+// rules are resolved with "zoneIDs".
+func (detector *Detector) detectRules(ctx context.Context, spec *Spec, rules []contextbasedrestrictionsv1.Rule, zoneIDs map[string]string, report *Report) error {
+	matched := make(map[int]bool)
+	for i := range spec.Rules {
+		desired := resolveZoneNames(&spec.Rules[i], zoneIDs)
+		id := core.StringNilMapper(desired.ID)
+		description := core.StringNilMapper(desired.Description)
+		index := -1
+		for j := range rules {
+			if id != "" && core.StringNilMapper(rules[j].ID) == id || id == "" && core.StringNilMapper(rules[j].Description) == description {
+				if index >= 0 {
+					return fmt.Errorf("rule %d of the spec has no ID, and more than one rule of the account has the description '%s'", i, description)
+				}
+				index = j
+			}
+		}
+		if index >= 0 && matched[index] {
+			return fmt.Errorf("more than one rule of the spec matches the rule '%s' of the account", core.StringNilMapper(rules[index].ID))
+		}
+		if index < 0 {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftDeleted, Resource: ResourceRule, Name: description, DesiredRule: desired})
+			continue
+		}
+		matched[index] = true
+
+		desiredFields := ruleFields(desired)
+		if len(compare(desiredFields, ruleFields(&rules[index]))) == 0 {
+			continue
+		}
+		// The rules returned by ListRules have no ETag: get the rule, and compare it again since it may have
+		// been modified in the meantime.
+		actual, response, err := detector.service.GetRuleWithContext(ctx, detector.service.NewGetRuleOptions(core.StringNilMapper(rules[index].ID)))
+		if err != nil {
+			return fmt.Errorf("error getting the rule '%s': %w", core.StringNilMapper(rules[index].ID), err)
+		}
+		if changes := compare(desiredFields, ruleFields(actual)); len(changes) > 0 {
+			report.Drifts = append(report.Drifts, Drift{
+				Kind:        DriftChanged,
+				Resource:    ResourceRule,
+				ID:          core.StringNilMapper(actual.ID),
+				Name:        description,
+				Changes:     changes,
+				DesiredRule: desired,
+				ETag:        response.GetHeaders().Get("ETag"),
+			})
+		}
+	}
+	for j := range rules {
+		if !matched[j] {
+			report.Drifts = append(report.Drifts, Drift{Kind: DriftAdded, Resource: ResourceRule, ID: core.StringNilMapper(rules[j].ID), Name: core.StringNilMapper(rules[j].Description)})
+		}
+	}
+	return nil
+}
+
+// Apply replaces the zones and rules of the account that drifted (DriftChanged) with their desired state. Each
+// replacement is conditioned on the ETag of the resource when the drift was detected, so a resource modified
+// since then is not replaced and an error is returned. The zones and rules that were added to or deleted from the
+// account are not modified. The drifts of the replaced resources are returned, along with the first error.
+func (detector *Detector) Apply(ctx context.Context, report *Report) (replaced []Drift, err error) {
+	for _, drift := range report.Drifts {
+		if drift.Kind != DriftChanged {
+			continue
+		}
+		var response *core.DetailedResponse
+		if drift.Resource == ResourceZone {
+			options := detector.service.NewReplaceZoneOptions(drift.ID, drift.ETag)
+			options.SetName(drift.Name)
+			options.SetAccountID(detector.accountID)
+			options.Description = drift.DesiredZone.Description
+			options.SetAddresses(drift.DesiredZone.Addresses)
+			options.SetExcluded(drift.DesiredZone.Excluded)
+			_, response, err = detector.service.ReplaceZoneWithContext(ctx, options)
+		} else {
+			options := detector.service.NewReplaceRuleOptions(drift.ID, drift.ETag)
+			options.Description = drift.DesiredRule.Description
+			options.SetContexts(drift.DesiredRule.Contexts)
+			options.SetResources(drift.DesiredRule.Resources)
+			options.Operations = drift.DesiredRule.Operations
+			options.EnforcementMode = drift.DesiredRule.EnforcementMode
+			_, response, err = detector.service.ReplaceRuleWithContext(ctx, options)
+		}
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusPreconditionFailed {
+				return replaced, fmt.Errorf("the %s '%s' was modified since the drift was detected: %w", drift.Resource, drift.Name, err)
+			}
+			return replaced, fmt.Errorf("error replacing the %s '%s': %w", drift.Resource, drift.Name, err)
+		}
+		replaced = append(replaced, drift)
+	}
+	return replaced, nil
+}
+
+// fields : The normalized fields of a zone or rule.
+type fields struct {
+	// The names and values of the single-valued fields.
+	scalars [][2]string
+
+	// The names and sorted, deduplicated values of the multi-valued fields.
+	sets [][]string
+
+	// The effective IP addresses of a zone, which are compared as a set and reported with its "addresses" field.
+	ips *contextbasedrestrictionsv1.IPSet
+}
+
+// compare returns the changes between the "desired" and "actual" fields of a resource.
+func compare(desired fields, actual fields) (changes []Change) {
+	for i, scalar := range desired.scalars {
+		if scalar[1] != actual.scalars[i][1] {
+			changes = append(changes, Change{Field: scalar[0], Desired: scalar[1], Actual: actual.scalars[i][1]})
+		}
+	}
+	for i, set := range desired.sets {
+		change := Change{Field: set[0]}
+		if set[0] == "addresses" && desired.ips != nil && !desired.ips.Equal(actual.ips) {
+			change.Added = rangeStrings(actual.ips.Subtract(desired.ips))
+			change.Removed = rangeStrings(desired.ips.Subtract(actual.ips))
+		}
+		for _, value := range actual.sets[i][1:] {
+			if !slices.Contains(set[1:], value) {
+				change.Added = append(change.Added, value)
+			}
+		}
+		for _, value := range set[1:] {
+			if !slices.Contains(actual.sets[i][1:], value) {
+				change.Removed = append(change.Removed, value)
+			}
+		}
+		if change.Added != nil || change.Removed != nil {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+// rangeStrings returns the ranges of "ips" as returned by IPRange.String.
+func rangeStrings(ips *contextbasedrestrictionsv1.IPSet) (values []string) {
+	for _, r := range ips.Ranges() {
+		values = append(values, r.String())
+	}
+	return
+}
+
+// zoneFields returns the normalized fields of "zone", whose addresses are compared as the effective addresses of
+// the zone (see contextbasedrestrictionsv1.Zone.EffectiveAddresses). An error is returned if an address is malformed.
+func zoneFields(zone *contextbasedrestrictionsv1.Zone) (result fields, err error) {
+	result.scalars = [][2]string{
+		{"name", core.StringNilMapper(zone.Name)},
+		{"description", core.StringNilMapper(zone.Description)},
+	}
+	addresses, err := zone.EffectiveAddresses()
+	if err != nil {
+		return result, err
+	}
+	var values []string
+	for _, vpc := range addresses.VPCs {
+		values = append(values, "vpc:"+vpc)
+	}
+	for _, ref := range addresses.ServiceRefs {
+		data, err := json.Marshal(ref)
+		if err != nil {
+			return result, err
+		}
+		values = append(values, "serviceRef:"+string(data))
+	}
+	result.sets = [][]string{set("addresses", values)}
+	result.ips = addresses.IPs
+	return
+}
+
+// ruleFields returns the normalized fields of "rule".
+func ruleFields(rule *contextbasedrestrictionsv1.Rule) (result fields) {
+	enforcementMode := core.StringNilMapper(rule.EnforcementMode)
+	if enforcementMode == "" {
+		enforcementMode = contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+	}
+	result.scalars = [][2]string{
+		{"description", core.StringNilMapper(rule.Description)},
+		{"enforcement_mode", enforcementMode},
+	}
+
+	var contexts []string
+	for _, ruleContext := range rule.Contexts {
+		var attributes []string
+		for _, attribute := range ruleContext.Attributes {
+			values := strings.Split(core.StringNilMapper(attribute.Value), ",")
+			for i := range values {
+				values[i] = strings.TrimSpace(values[i])
+			}
+			sort.Strings(values)
+			attributes = append(attributes, core.StringNilMapper(attribute.Name)+"="+strings.Join(values, ","))
+		}
+		sort.Strings(attributes)
+		contexts = append(contexts, "{"+strings.Join(attributes, ", ")+"}")
+	}
+
+	var resources []string
+	for _, resource := range rule.Resources {
+		var attributes []string
+		for _, attribute := range resource.Attributes {
+			attributes = append(attributes, matchString(core.StringNilMapper(attribute.Name), attribute.Operator, core.StringNilMapper(attribute.Value)))
+		}
+		for _, tag := range resource.Tags {
+			attributes = append(attributes, matchString("tag:"+core.StringNilMapper(tag.Name), tag.Operator, core.StringNilMapper(tag.Value)))
+		}
+		sort.Strings(attributes)
+		resources = append(resources, "{"+strings.Join(attributes, ", ")+"}")
+	}
+
+	var operations []string
+	if rule.Operations != nil {
+		for _, item := range rule.Operations.APITypes {
+			operations = append(operations, core.StringNilMapper(item.APITypeID))
+		}
+	}
+	result.sets = [][]string{set("contexts", contexts), set("resources", resources), set("operations", operations)}
+	return
+}
+
+// matchString returns the normalized form of a resource attribute or tag.
+func matchString(name string, operator *string, value string) string {
+	if operator == nil || *operator == "" || *operator == "stringEquals" {
+		return name + "=" + value
+	}
+	return fmt.Sprintf("%s %s %s", name, *operator, value)
+}
+
+// set returns "values" sorted and deduplicated, preceded by "name".
+func set(name string, values []string) []string {
+	sort.Strings(values)
+	return append([]string{name}, slices.Compact(values)...)
+}
+
+// resolveZoneNames returns a copy of "rule" whose "networkZoneId" context attributes reference the zones by ID
+// instead of by name. The values that are not the name of a zone of "zoneIDs" are left unchanged.
+func resolveZoneNames(rule *contextbasedrestrictionsv1.Rule, zoneIDs map[string]string) *contextbasedrestrictionsv1.Rule {
+	result := *rule
+	result.Contexts = make([]contextbasedrestrictionsv1.RuleContext, len(rule.Contexts))
+	for i, ruleContext := range rule.Contexts {
+		result.Contexts[i].Attributes = slices.Clone(ruleContext.Attributes)
+		for j, attribute := range result.Contexts[i].Attributes {
+			if core.StringNilMapper(attribute.Name) != contextAttributeNetworkZoneID {
+				continue
+			}
+			values := strings.Split(core.StringNilMapper(attribute.Value), ",")
+			for k, value := range values {
+				value = strings.TrimSpace(value)
+				if id, found := zoneIDs[value]; found {
+					value = id
+				}
+				values[k] = value
+			}
+			result.Contexts[i].Attributes[j].Value = core.StringPtr(strings.Join(values, ","))
+		}
+	}
+	return &result
+}
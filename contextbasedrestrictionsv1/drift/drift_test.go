@@ -0,0 +1,259 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const accountID = "12ab34cd56ef78ab90cd12ef34ab56cd"
+
+const testSpec = `
+zones:
+- name: office
+  description: Office network
+  addresses:
+  - {type: subnet, value: 10.0.0.0/24}
+  - {type: ipRange, value: 169.23.22.10-169.23.22.20}
+  - {type: ipAddress, value: "2001:db8:0:0::1"}
+  - {type: serviceRef, ref: {account_id: ` + accountID + `, service_name: containers-kubernetes}}
+  excluded:
+  - {type: ipAddress, value: 10.0.0.1}
+- name: lab
+  addresses:
+  - {type: subnet, value: 192.168.0.0/16}
+rules:
+- description: COS from the office
+  contexts:
+  - attributes:
+    - {name: networkZoneId, value: office}
+    - {name: endpointType, value: "public, private"}
+  resources:
+  - attributes:
+    - {name: accountId, value: ` + accountID + `}
+    - {name: serviceName, value: cloud-object-storage, operator: stringEquals}
+  enforcement_mode: enabled
+- id: r3
+  description: Deleted rule
+  contexts: []
+  resources: []
+`
+
+// newServer returns a server with the zones and rules of the account, and records the requests that replace them.
+func newServer(t *testing.T, replaced map[string]string) *httptest.Server {
+	responses := map[string]string{
+		"/v1/zones": `{"count": 2, "zones": [{"id": "z1", "name": "office"}, {"id": "z2", "name": "partners"}]}`,
+		"/v1/zones/z1": `{"id": "z1", "name": "office", "description": "Office network", "account_id": "` + accountID + `",
+			"addresses": [
+				{"type": "ipAddress", "value": "2001:db8::1"},
+				{"type": "ipRange", "value": "10.0.0.0-10.0.0.255"},
+				{"type": "serviceRef", "ref": {"service_name": "containers-kubernetes", "account_id": "` + accountID + `"}},
+				{"type": "ipRange", "value": "169.23.22.10-169.23.22.20"},
+				{"type": "subnet", "value": "169.23.30.0/24"}
+			],
+			"excluded": [{"type": "subnet", "value": "10.0.0.1/32"}]}`,
+		"/v1/zones/z2": `{"id": "z2", "name": "partners", "addresses": [{"type": "vpc", "value": "crn:vpc"}], "excluded": []}`,
+		"/v1/rules": `{"count": 2, "rules": [
+			{"id": "r1", "description": "COS from the office", "enforcement_mode": "report",
+			 "contexts": [{"attributes": [{"name": "endpointType", "value": "private,public"}, {"name": "networkZoneId", "value": "z1"}]}],
+			 "resources": [{"attributes": [{"name": "serviceName", "value": "cloud-object-storage"}, {"name": "accountId", "value": "` + accountID + `"}]}]},
+			{"id": "r2", "description": "Manual rule", "contexts": [], "resources": []}]}`,
+	}
+	responses["/v1/rules/r1"] = `{"id": "r1", "description": "COS from the office", "enforcement_mode": "report",
+		"contexts": [{"attributes": [{"name": "endpointType", "value": "private,public"}, {"name": "networkZoneId", "value": "z1"}]}],
+		"resources": [{"attributes": [{"name": "serviceName", "value": "cloud-object-storage"}, {"name": "accountId", "value": "` + accountID + `"}]}]}`
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodPut {
+			if req.Header.Get("If-Match") != req.URL.Path+"-etag" {
+				res.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = io.WriteString(res, `{"errors": [{"code": "precondition_failed", "message": "modified"}]}`)
+				return
+			}
+			data, err := io.ReadAll(req.Body)
+			assert.Nil(t, err)
+			replaced[req.URL.Path] = string(data)
+			_, _ = io.WriteString(res, `{}`)
+			return
+		}
+		response, ok := responses[req.URL.Path]
+		if !ok || req.Method != http.MethodGet {
+			res.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if strings.Count(req.URL.Path, "/") == 3 {
+			res.Header().Set("ETag", req.URL.Path+"-etag")
+		}
+		_, _ = io.WriteString(res, response)
+	}))
+}
+
+func newDetector(t *testing.T, server *httptest.Server) *Detector {
+	service, err := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	detector, err := New(service, accountID)
+	assert.Nil(t, err)
+	return detector
+}
+
+func TestDetect(t *testing.T) {
+	server := newServer(t, nil)
+	defer server.Close()
+	detector := newDetector(t, server)
+
+	spec, err := LoadSpec(strings.NewReader(testSpec))
+	assert.Nil(t, err)
+	report, err := detector.Detect(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.True(t, report.HasDrift())
+
+	var output bytes.Buffer
+	assert.Nil(t, report.Write(&output))
+	assert.Equal(t, `~ zone 'office' (z1)
+    addresses: +169.23.30.0/24
+- zone 'lab'
++ zone 'partners' (z2)
+~ rule 'COS from the office' (r1)
+    enforcement_mode: "report" -> "enabled"
+- rule 'Deleted rule'
++ rule 'Manual rule' (r2)
+`, output.String())
+
+	office := report.Drifts[0]
+	assert.Equal(t, "/v1/zones/z1-etag", office.ETag)
+	assert.Equal(t, "office", *office.DesiredZone.Name)
+	rule := report.Drifts[3]
+	assert.Equal(t, "/v1/rules/r1-etag", rule.ETag)
+	assert.Equal(t, "z1", *rule.DesiredRule.Contexts[0].Attributes[0].Value)
+	assert.Equal(t, "office", *spec.Rules[0].Contexts[0].Attributes[0].Value)
+
+	// All the zones and rules of the account are added with respect to an empty spec.
+	spec = &Spec{}
+	report, err = detector.Detect(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Len(t, report.Drifts, 4)
+	assert.Equal(t, DriftAdded, report.Drifts[0].Kind)
+}
+
+func TestDetectErrors(t *testing.T) {
+	server := newServer(t, nil)
+	defer server.Close()
+	detector := newDetector(t, server)
+
+	for spec, message := range map[string]string{
+		`{"zones": [{"name": "a"}, {"name": "a"}]}`:                                                    "more than one zone of the spec is named 'a'",
+		`{"zones": [{"description": "a"}]}`:                                                            "zone 0 of the spec has no name",
+		`{"zones": [{"name": "a", "addresses": [{"type": "ipRange", "value": "10.0.0.2-10.0.0.1"}]}]}`: "zone 'a' of the spec: invalid IP address range '10.0.0.2-10.0.0.1'",
+		`{"zones": [{"name": "a", "addresses": [{"type": "subnet", "value": "10.0.0.0/33"}]}]}`:        "zone 'a' of the spec: invalid subnet '10.0.0.0/33'",
+		`{"rules": [{"id": "r1"}, {"description": "COS from the office"}]}`:                            "more than one rule of the spec matches the rule 'r1' of the account",
+	} {
+		parsed, err := LoadSpec(strings.NewReader(spec))
+		assert.Nil(t, err)
+		_, err = detector.Detect(context.Background(), parsed)
+		assert.ErrorContains(t, err, message)
+	}
+
+	_, err := LoadSpec(strings.NewReader(`zones: [`))
+	assert.ErrorContains(t, err, "error parsing the spec")
+	_, err = New(nil, accountID)
+	assert.NotNil(t, err)
+}
+
+func TestCompareZoneAddresses(t *testing.T) {
+	zone := func(addresses []string, excluded ...string) *contextbasedrestrictionsv1.Zone {
+		toAddresses := func(values []string) (result []contextbasedrestrictionsv1.AddressIntf) {
+			for _, value := range values {
+				addressType := "ipAddress"
+				if strings.Contains(value, "/") {
+					addressType = "subnet"
+				} else if strings.Contains(value, "-") {
+					addressType = "ipRange"
+				}
+				result = append(result, &contextbasedrestrictionsv1.Address{Type: core.StringPtr(addressType), Value: core.StringPtr(value)})
+			}
+			return
+		}
+		return &contextbasedrestrictionsv1.Zone{Name: core.StringPtr("z"), Addresses: toAddresses(addresses), Excluded: toAddresses(excluded)}
+	}
+	changes := func(desired *contextbasedrestrictionsv1.Zone, actual *contextbasedrestrictionsv1.Zone) []Change {
+		desiredFields, err := zoneFields(desired)
+		assert.Nil(t, err)
+		actualFields, err := zoneFields(actual)
+		assert.Nil(t, err)
+		return compare(desiredFields, actualFields)
+	}
+
+	desired := zone([]string{"10.0.0.0/24"})
+	// The same effective IP addresses, split, overlapping or with different excluded addresses.
+	assert.Empty(t, changes(desired, zone([]string{"10.0.0.0/25", "10.0.0.128/25"})))
+	assert.Empty(t, changes(desired, zone([]string{"10.0.0.0-10.0.0.200", "10.0.0.100-10.0.0.255", "10.0.0.7"})))
+	assert.Empty(t, changes(zone([]string{"10.0.0.0/24", "10.0.1.0/24"}, "10.0.1.0/24"), desired))
+	assert.Empty(t, changes(zone([]string{"::ffff:10.0.0.1"}), zone([]string{"10.0.0.1/32"})))
+
+	assert.Equal(t, []Change{{Field: "addresses", Added: []string{"10.0.1.0/24"}, Removed: []string{"10.0.0.1", "10.0.0.128/25"}}},
+		changes(desired, zone([]string{"10.0.0.0/24", "10.0.1.0/24"}, "10.0.0.1", "10.0.0.128/25")))
+
+	_, err := zoneFields(zone([]string{"10.0.0.1-2001:db8::1"}))
+	assert.ErrorContains(t, err, "invalid IP address range")
+}
+
+func TestApply(t *testing.T) {
+	replaced := make(map[string]string)
+	server := newServer(t, replaced)
+	defer server.Close()
+	detector := newDetector(t, server)
+
+	spec, err := LoadSpec(strings.NewReader(testSpec))
+	assert.Nil(t, err)
+	report, err := detector.Detect(context.Background(), spec)
+	assert.Nil(t, err)
+
+	applied, err := detector.Apply(context.Background(), report)
+	assert.Nil(t, err)
+	assert.Len(t, applied, 2)
+	assert.Len(t, replaced, 2)
+
+	var zone map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(replaced["/v1/zones/z1"]), &zone))
+	assert.Equal(t, "office", zone["name"])
+	assert.Equal(t, accountID, zone["account_id"])
+	assert.Len(t, zone["addresses"], 4)
+	var rule map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(replaced["/v1/rules/r1"]), &rule))
+	assert.Equal(t, "enabled", rule["enforcement_mode"])
+	assert.Equal(t, map[string]interface{}{"name": "networkZoneId", "value": "z1"}, rule["contexts"].([]interface{})[0].(map[string]interface{})["attributes"].([]interface{})[0])
+
+	// The zone was modified since the drift was detected.
+	report.Drifts[0].ETag = "stale"
+	applied, err = detector.Apply(context.Background(), report)
+	assert.ErrorContains(t, err, "the zone 'office' was modified since the drift was detected")
+	assert.Empty(t, applied)
+}
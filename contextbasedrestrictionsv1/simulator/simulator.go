@@ -0,0 +1,340 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package simulator evaluates context-based restrictions rules locally, to determine which rules
+// apply to a request and whether the request would be allowed, denied or only reported:
+//
+//	sim, err := simulator.New(zones, ruleList.Rules)
+//	result, err := sim.Simulate(&simulator.Request{
+//		SourceIP:     "169.23.56.234",
+//		EndpointType: simulator.EndpointTypePrivate,
+//		Resource:     map[string]string{"accountId": accountID, "serviceName": "cloud-object-storage"},
+//	})
+//	if result.Decision == simulator.DecisionDenied { ... }
+//
+// Note that the ZoneSummary values returned by ListZones do not include the addresses of the zones,
+// so the zones must be retrieved with GetZone.
+//
+// A rule applies to a request if one of its resources matches the target resource and its operations
+// include the API type of the request. An applicable rule is satisfied if all the attributes of one of
+// its contexts match the request; a rule without contexts is never satisfied. The request is denied if an
+// applicable rule in "enabled" mode is not satisfied, and reported if an applicable rule in "report" mode
+// is not satisfied. Rules in "disabled" mode are ignored.
+package simulator
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// Decisions reported by Simulate.
+const (
+	// The request satisfies all the applicable rules.
+	DecisionAllowed = "allowed"
+
+	// The request does not satisfy an applicable rule in "enabled" mode.
+	DecisionDenied = "denied"
+
+	// The request satisfies all the applicable rules in "enabled" mode,
+	// but not an applicable rule in "report" mode.
+	DecisionReport = "report"
+)
+
+// Names of the context attributes.
+const (
+	ContextAttributeNetworkZoneID = "networkZoneId"
+	ContextAttributeEndpointType  = "endpointType"
+)
+
+// Values of the "endpointType" context attribute.
+const (
+	EndpointTypePublic  = "public"
+	EndpointTypePrivate = "private"
+	EndpointTypeDirect  = "direct"
+)
+
+// APITypeAll is the API type that includes all the API types of a service.
+const APITypeAll = "crn:v1:bluemix:public:context-based-restrictions::::api-type:"
+
+// Simulator : Evaluates requests against a set of rules and the zones they reference.
+type Simulator struct {
+	zones map[string]*zone
+	rules []contextbasedrestrictionsv1.Rule
+}
+
+// Request : The description of the request to simulate.
+type Request struct {
+	// The IP address the request originates from.
+	SourceIP string
+
+	// The CRN of the VPC the request originates from, if any.
+	SourceVpcCRN string
+
+	// The service the request originates from, if any, matched against the "serviceRef" addresses of zones.
+	SourceService *contextbasedrestrictionsv1.ServiceRefValue
+
+	// The type of endpoint the request is sent to (e.g. EndpointTypePrivate).
+	EndpointType string
+
+	// Other context attributes of the request (e.g. "mfa"), keyed by name.
+	ContextAttributes map[string]string
+
+	// The attributes of the target resource (e.g. "accountId", "serviceName", "serviceInstance").
+	Resource map[string]string
+
+	// The access tags of the target resource, in the form "name:value".
+	ResourceTags []string
+
+	// The API type of the operation (e.g. "crn:v1:bluemix:public:containers-kubernetes::::api-type:management").
+	// If empty, every rule applies regardless of its operations.
+	APIType string
+}
+
+// Result : The result of a simulation.
+type Result struct {
+	// The decision for the request: DecisionAllowed, DecisionDenied or DecisionReport.
+	Decision string
+
+	// The rules that apply to the request, excluding those in "disabled" mode.
+	Rules []RuleResult
+}
+
+// RuleResult : The evaluation of a rule that applies to a request.
+type RuleResult struct {
+	// The rule.
+	Rule contextbasedrestrictionsv1.Rule
+
+	// The enforcement mode of the rule. Defaults to "enabled".
+	EnforcementMode string
+
+	// True if the request matches one of the contexts of the rule.
+	Satisfied bool
+
+	// The context matched by the request, if any.
+	Context *contextbasedrestrictionsv1.RuleContext
+}
+
+// zone is the parsed form of the addresses of a Zone.
+type zone struct {
+	ips      *contextbasedrestrictionsv1.IPSet
+	vpcs     []string
+	services []contextbasedrestrictionsv1.ServiceRefValue
+}
+
+// New returns a Simulator for the specified zones and rules.
+// An error is returned if an address of a zone is malformed.
+func New(zones []contextbasedrestrictionsv1.Zone, rules []contextbasedrestrictionsv1.Rule) (*Simulator, error) {
+	sim := &Simulator{
+		zones: map[string]*zone{},
+		rules: slices.Clone(rules),
+	}
+	for _, z := range zones {
+		parsed, err := parseZone(&z)
+		if err != nil {
+			return nil, fmt.Errorf("zone '%s': %w", core.StringNilMapper(z.ID), err)
+		}
+		sim.zones[core.StringNilMapper(z.ID)] = parsed
+	}
+	return sim, nil
+}
+
+// Simulate returns the result of the evaluation of "req" against the rules.
+// An error is returned if a rule references a zone that is not known to the simulator.
+func (sim *Simulator) Simulate(req *Request) (*Result, error) {
+	if req == nil {
+		return nil, fmt.Errorf("the request must not be nil")
+	}
+
+	var source netip.Addr
+	if req.SourceIP != "" {
+		var err error
+		source, err = netip.ParseAddr(req.SourceIP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source IP address '%s'", req.SourceIP)
+		}
+	}
+
+	result := &Result{Decision: DecisionAllowed}
+	for _, rule := range sim.rules {
+		mode := core.StringNilMapper(rule.EnforcementMode)
+		if mode == "" {
+			mode = contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+		}
+		if mode == contextbasedrestrictionsv1.RuleEnforcementModeDisabledConst || !appliesTo(&rule, req) {
+			continue
+		}
+
+		ruleResult := RuleResult{Rule: rule, EnforcementMode: mode}
+		for i := range rule.Contexts {
+			satisfied, err := sim.satisfies(&rule.Contexts[i], req, source)
+			if err != nil {
+				return nil, fmt.Errorf("rule '%s': %w", core.StringNilMapper(rule.ID), err)
+			}
+			if satisfied {
+				ruleResult.Satisfied = true
+				ruleResult.Context = &rule.Contexts[i]
+				break
+			}
+		}
+		result.Rules = append(result.Rules, ruleResult)
+
+		if !ruleResult.Satisfied {
+			if mode == contextbasedrestrictionsv1.RuleEnforcementModeReportConst {
+				if result.Decision == DecisionAllowed {
+					result.Decision = DecisionReport
+				}
+			} else {
+				result.Decision = DecisionDenied
+			}
+		}
+	}
+	return result, nil
+}
+
+// appliesTo returns true if one of the resources of "rule" matches the target resource of "req"
+// and the operations of "rule" include the API type of "req".
+func appliesTo(rule *contextbasedrestrictionsv1.Rule, req *Request) bool {
+	if req.APIType != "" && rule.Operations != nil && len(rule.Operations.APITypes) > 0 {
+		covered := slices.ContainsFunc(rule.Operations.APITypes, func(item contextbasedrestrictionsv1.NewRuleOperationsAPITypesItem) bool {
+			id := core.StringNilMapper(item.APITypeID)
+			return id == req.APIType || id == APITypeAll
+		})
+		if !covered {
+			return false
+		}
+	}
+	return slices.ContainsFunc(rule.Resources, func(resource contextbasedrestrictionsv1.Resource) bool {
+		return matchesResource(&resource, req)
+	})
+}
+
+// matchesResource returns true if all the attributes and tags of "resource" match the target resource of "req".
+func matchesResource(resource *contextbasedrestrictionsv1.Resource, req *Request) bool {
+	for _, attribute := range resource.Attributes {
+		value, ok := req.Resource[core.StringNilMapper(attribute.Name)]
+		if !ok || !matchString(core.StringNilMapper(attribute.Operator), core.StringNilMapper(attribute.Value), value) {
+			return false
+		}
+	}
+	for _, tag := range resource.Tags {
+		matched := slices.ContainsFunc(req.ResourceTags, func(resourceTag string) bool {
+			name, value, found := strings.Cut(resourceTag, ":")
+			return found && name == core.StringNilMapper(tag.Name) && matchString(core.StringNilMapper(tag.Operator), core.StringNilMapper(tag.Value), value)
+		})
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// satisfies returns true if all the attributes of "context" match "req".
+func (sim *Simulator) satisfies(context *contextbasedrestrictionsv1.RuleContext, req *Request, source netip.Addr) (bool, error) {
+	for _, attribute := range context.Attributes {
+		name := core.StringNilMapper(attribute.Name)
+		values := strings.Split(core.StringNilMapper(attribute.Value), ",")
+
+		switch name {
+		case ContextAttributeNetworkZoneID:
+			matched := false
+			for _, id := range values {
+				z, ok := sim.zones[strings.TrimSpace(id)]
+				if !ok {
+					return false, fmt.Errorf("zone '%s' not found", strings.TrimSpace(id))
+				}
+				if z.matches(req, source) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false, nil
+			}
+		case ContextAttributeEndpointType:
+			if !containsTrimmed(values, req.EndpointType) {
+				return false, nil
+			}
+		default:
+			value, ok := req.ContextAttributes[name]
+			if !ok || !containsTrimmed(values, value) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// matches returns true if the source of "req" is one of the addresses of the zone.
+func (z *zone) matches(req *Request, source netip.Addr) bool {
+	if source.IsValid() && z.ips.Contains(source) {
+		return true
+	}
+	if req.SourceVpcCRN != "" && slices.Contains(z.vpcs, req.SourceVpcCRN) {
+		return true
+	}
+	if req.SourceService != nil {
+		for _, ref := range z.services {
+			if matchesServiceRef(&ref, req.SourceService) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesServiceRef returns true if each field set in "ref" is equal to the same field of "service".
+func matchesServiceRef(ref *contextbasedrestrictionsv1.ServiceRefValue, service *contextbasedrestrictionsv1.ServiceRefValue) bool {
+	for _, field := range [][2]*string{
+		{ref.AccountID, service.AccountID},
+		{ref.ServiceType, service.ServiceType},
+		{ref.ServiceName, service.ServiceName},
+		{ref.ServiceInstance, service.ServiceInstance},
+		{ref.Location, service.Location},
+	} {
+		if field[0] != nil && *field[0] != core.StringNilMapper(field[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseZone(z *contextbasedrestrictionsv1.Zone) (*zone, error) {
+	addresses, err := z.EffectiveAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return &zone{ips: addresses.IPs, vpcs: addresses.VPCs, services: addresses.ServiceRefs}, nil
+}
+
+// matchString returns true if "value" matches "expected" with the operator of a resource attribute:
+// "stringEquals" (the default) or "stringMatch", in which "*" matches any sequence of characters
+// and "?" matches any single character.
+func matchString(operator string, expected string, value string) bool {
+	if operator != "stringMatch" {
+		return value == expected
+	}
+	return common.WildcardMatch(expected, value)
+}
+
+func containsTrimmed(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool { return strings.TrimSpace(v) == value })
+}
@@ -0,0 +1,244 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	accountID = "12ab34cd56ef78ab90cd12ef34ab56cd"
+	vpcCRN    = "crn:v1:bluemix:public:is:us-south:a/" + accountID + "::vpc:r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"
+)
+
+func unmarshalZone(t *testing.T, s string) contextbasedrestrictionsv1.Zone {
+	var raw map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal([]byte(s), &raw))
+	var zone *contextbasedrestrictionsv1.Zone
+	assert.Nil(t, contextbasedrestrictionsv1.UnmarshalZone(raw, &zone))
+	return *zone
+}
+
+func testZones(t *testing.T) []contextbasedrestrictionsv1.Zone {
+	return []contextbasedrestrictionsv1.Zone{
+		unmarshalZone(t, `{
+			"id": "office",
+			"addresses": [
+				{"type": "ipAddress", "value": "169.23.56.234"},
+				{"type": "ipRange", "value": "169.23.22.0-169.23.22.255"},
+				{"type": "subnet", "value": "10.0.0.0/8"},
+				{"type": "subnet", "value": "2001:db8::/32"}
+			],
+			"excluded": [{"type": "subnet", "value": "10.10.0.0/16"}]
+		}`),
+		unmarshalZone(t, `{
+			"id": "services",
+			"addresses": [
+				{"type": "vpc", "value": "`+vpcCRN+`"},
+				{"type": "serviceRef", "ref": {"account_id": "`+accountID+`", "service_name": "containers-kubernetes"}}
+			],
+			"excluded": []
+		}`),
+	}
+}
+
+func testRule(id string, mode string, contexts ...contextbasedrestrictionsv1.RuleContext) contextbasedrestrictionsv1.Rule {
+	return contextbasedrestrictionsv1.Rule{
+		ID:       core.StringPtr(id),
+		Contexts: contexts,
+		Resources: []contextbasedrestrictionsv1.Resource{
+			{
+				Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+					{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage")},
+				},
+			},
+		},
+		EnforcementMode: core.StringPtr(mode),
+	}
+}
+
+func testContext(zoneIDs string, endpointTypes string) contextbasedrestrictionsv1.RuleContext {
+	context := contextbasedrestrictionsv1.RuleContext{}
+	if zoneIDs != "" {
+		context.Attributes = append(context.Attributes, contextbasedrestrictionsv1.RuleContextAttribute{
+			Name: core.StringPtr(ContextAttributeNetworkZoneID), Value: core.StringPtr(zoneIDs),
+		})
+	}
+	if endpointTypes != "" {
+		context.Attributes = append(context.Attributes, contextbasedrestrictionsv1.RuleContextAttribute{
+			Name: core.StringPtr(ContextAttributeEndpointType), Value: core.StringPtr(endpointTypes),
+		})
+	}
+	return context
+}
+
+func cosRequest(sourceIP string) *Request {
+	return &Request{
+		SourceIP:     sourceIP,
+		EndpointType: EndpointTypePrivate,
+		Resource:     map[string]string{"accountId": accountID, "serviceName": "cloud-object-storage", "serviceInstance": "instance-id"},
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	sim, err := New(testZones(t), []contextbasedrestrictionsv1.Rule{
+		testRule("cos", contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst, testContext("office,services", "private,direct")),
+	})
+	assert.Nil(t, err)
+
+	for sourceIP, decision := range map[string]string{
+		"169.23.56.234": DecisionAllowed,
+		"169.23.56.235": DecisionDenied,
+		"169.23.22.17":  DecisionAllowed,
+		"10.1.2.3":      DecisionAllowed,
+		"10.10.2.3":     DecisionDenied,
+		"2001:db8::1":   DecisionAllowed,
+		"2001:db9::1":   DecisionDenied,
+	} {
+		result, err := sim.Simulate(cosRequest(sourceIP))
+		assert.Nil(t, err, sourceIP)
+		assert.Equal(t, decision, result.Decision, sourceIP)
+		assert.Len(t, result.Rules, 1, sourceIP)
+		assert.Equal(t, decision == DecisionAllowed, result.Rules[0].Satisfied, sourceIP)
+	}
+
+	req := cosRequest("169.23.56.234")
+	req.EndpointType = EndpointTypePublic
+	result, err := sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionDenied, result.Decision)
+
+	req = cosRequest("")
+	req.SourceVpcCRN = vpcCRN
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+	assert.Equal(t, "office,services", *result.Rules[0].Context.Attributes[0].Value)
+
+	req = cosRequest("")
+	req.SourceService = &contextbasedrestrictionsv1.ServiceRefValue{
+		AccountID:   core.StringPtr(accountID),
+		ServiceName: core.StringPtr("containers-kubernetes"),
+		Location:    core.StringPtr("us-south"),
+	}
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+
+	req.SourceService.ServiceName = core.StringPtr("codeengine")
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionDenied, result.Decision)
+
+	req = cosRequest("1.2.3.4")
+	req.Resource["serviceName"] = "kms"
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+	assert.Empty(t, result.Rules)
+}
+
+func TestSimulateEnforcementModes(t *testing.T) {
+	sim, err := New(testZones(t), []contextbasedrestrictionsv1.Rule{
+		testRule("report", contextbasedrestrictionsv1.RuleEnforcementModeReportConst, testContext("office", "")),
+		testRule("disabled", contextbasedrestrictionsv1.RuleEnforcementModeDisabledConst),
+		testRule("public", contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst, testContext("", "public"), testContext("", "private")),
+	})
+	assert.Nil(t, err)
+
+	result, err := sim.Simulate(cosRequest("169.23.56.234"))
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+	assert.Len(t, result.Rules, 2)
+
+	result, err = sim.Simulate(cosRequest("1.2.3.4"))
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionReport, result.Decision)
+	assert.Equal(t, "report", *result.Rules[0].Rule.ID)
+	assert.False(t, result.Rules[0].Satisfied)
+	assert.True(t, result.Rules[1].Satisfied)
+
+	req := cosRequest("1.2.3.4")
+	req.EndpointType = EndpointTypeDirect
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionDenied, result.Decision)
+}
+
+func TestSimulateOperationsAndTags(t *testing.T) {
+	rule := testRule("management", contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst)
+	rule.Resources[0].Attributes[1].Value = core.StringPtr("containers-kubernetes")
+	rule.Resources[0].Tags = []contextbasedrestrictionsv1.ResourceTagAttribute{
+		{Name: core.StringPtr("env"), Value: core.StringPtr("prod*"), Operator: core.StringPtr("stringMatch")},
+	}
+	rule.Operations = &contextbasedrestrictionsv1.NewRuleOperations{
+		APITypes: []contextbasedrestrictionsv1.NewRuleOperationsAPITypesItem{
+			{APITypeID: core.StringPtr("crn:v1:bluemix:public:containers-kubernetes::::api-type:management")},
+		},
+	}
+	sim, err := New(nil, []contextbasedrestrictionsv1.Rule{rule})
+	assert.Nil(t, err)
+
+	req := &Request{
+		Resource:     map[string]string{"accountId": accountID, "serviceName": "containers-kubernetes"},
+		ResourceTags: []string{"env:production"},
+		APIType:      "crn:v1:bluemix:public:containers-kubernetes::::api-type:management",
+	}
+	result, err := sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionDenied, result.Decision)
+
+	req.APIType = "crn:v1:bluemix:public:containers-kubernetes::::api-type:cluster"
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+
+	req.APIType = ""
+	req.ResourceTags = []string{"env:dev"}
+	result, err = sim.Simulate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, DecisionAllowed, result.Decision)
+}
+
+func TestSimulateErrors(t *testing.T) {
+	_, err := New([]contextbasedrestrictionsv1.Zone{
+		unmarshalZone(t, `{"id": "bad", "addresses": [{"type": "ipRange", "value": "10.0.0.9-10.0.0.1"}]}`),
+	}, nil)
+	assert.NotNil(t, err)
+
+	_, err = New([]contextbasedrestrictionsv1.Zone{
+		unmarshalZone(t, `{"id": "bad", "addresses": [{"type": "subnet", "value": "10.0.0.0"}]}`),
+	}, nil)
+	assert.NotNil(t, err)
+
+	sim, err := New(nil, []contextbasedrestrictionsv1.Rule{
+		testRule("cos", contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst, testContext("missing", "")),
+	})
+	assert.Nil(t, err)
+	_, err = sim.Simulate(cosRequest("1.2.3.4"))
+	assert.NotNil(t, err)
+	_, err = sim.Simulate(cosRequest("not-an-ip"))
+	assert.NotNil(t, err)
+	_, err = sim.Simulate(nil)
+	assert.NotNil(t, err)
+}
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -1195,6 +1196,13 @@ func (pager *BillingUnitsPager) GetAll() (allItems []BillingUnit, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *BillingUnitsPager) Items(ctx context.Context) iter.Seq2[BillingUnit, error] {
+	return common.Items[BillingUnit](ctx, pager)
+}
+
 //
 // BillingOptionsPager can be used to simplify the use of the "ListBillingOptions" method.
 //
@@ -1281,3 +1289,10 @@ func (pager *BillingOptionsPager) GetNext() (page []BillingOption, err error) {
 func (pager *BillingOptionsPager) GetAll() (allItems []BillingOption, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *BillingOptionsPager) Items(ctx context.Context) iter.Seq2[BillingOption, error] {
+	return common.Items[BillingOption](ctx, pager)
+}
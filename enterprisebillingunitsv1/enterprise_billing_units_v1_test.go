@@ -714,6 +714,32 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingUnitsPager.Items successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingUnitsOptionsModel := &enterprisebillingunitsv1.ListBillingUnitsOptions{
+					AccountID: core.StringPtr("testString"),
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingUnitsPager(listBillingUnitsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingUnit
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListBillingOptions(listBillingOptionsOptions *ListBillingOptionsOptions) - Operation response error`, func() {
@@ -1042,6 +1068,30 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingOptionsPager.Items successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingOptionsOptionsModel := &enterprisebillingunitsv1.ListBillingOptionsOptions{
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingOptionsPager(listBillingOptionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingOption
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCreditPools(getCreditPoolsOptions *GetCreditPoolsOptions) - Operation response error`, func() {
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -2826,6 +2827,13 @@ func (pager *EnterprisesPager) GetAll() (allItems []Enterprise, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *EnterprisesPager) Items(ctx context.Context) iter.Seq2[Enterprise, error] {
+	return common.Items[Enterprise](ctx, pager)
+}
+
 // AccountsPager can be used to simplify the use of the "ListAccounts" method.
 type AccountsPager struct {
 	hasNext     bool
@@ -2918,6 +2926,13 @@ func (pager *AccountsPager) GetAll() (allItems []Account, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *AccountsPager) Items(ctx context.Context) iter.Seq2[Account, error] {
+	return common.Items[Account](ctx, pager)
+}
+
 // AccountGroupsPager can be used to simplify the use of the "ListAccountGroups" method.
 type AccountGroupsPager struct {
 	hasNext     bool
@@ -3009,3 +3024,10 @@ func (pager *AccountGroupsPager) GetAll() (allItems []AccountGroup, err error) {
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *AccountGroupsPager) Items(ctx context.Context) iter.Seq2[AccountGroup, error] {
+	return common.Items[AccountGroup](ctx, pager)
+}
@@ -762,6 +762,32 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterprisesPager.Items successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listEnterprisesOptionsModel := &enterprisemanagementv1.ListEnterprisesOptions{
+					EnterpriseAccountID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseManagementService.NewEnterprisesPager(listEnterprisesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Enterprise
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetEnterprise(getEnterpriseOptions *GetEnterpriseOptions) - Operation response error`, func() {
@@ -1814,6 +1840,33 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountsPager.Items successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountsOptionsModel := &enterprisemanagementv1.ListAccountsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountsPager(listAccountsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Account
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetAccount(getAccountOptions *GetAccountOptions) - Operation response error`, func() {
@@ -2785,6 +2838,33 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountGroupsPager.Items successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountGroupsOptionsModel := &enterprisemanagementv1.ListAccountGroupsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					ParentAccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountGroupsPager(listAccountGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.AccountGroup
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetAccountGroup(getAccountGroupOptions *GetAccountGroupOptions) - Operation response error`, func() {
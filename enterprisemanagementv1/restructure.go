@@ -0,0 +1,349 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the RestructureStep.Action property.
+// The change made by a step of a restructure plan.
+const (
+	RestructureStepActionCreateAccountGroupConst = "create_account_group"
+	RestructureStepActionUpdateAccountGroupConst = "update_account_group"
+	RestructureStepActionMoveAccountConst        = "move_account"
+)
+
+// EnterpriseLayout : The desired hierarchy of an enterprise, passed to PlanRestructure.
+// Account groups and accounts of the enterprise that are not part of the layout are left unchanged.
+type EnterpriseLayout struct {
+	// The account groups whose parent is the enterprise.
+	AccountGroups []*AccountGroupLayout
+
+	// The IDs of the accounts whose parent is the enterprise.
+	Accounts []string
+}
+
+// AccountGroupLayout : The desired state of an account group in an EnterpriseLayout.
+type AccountGroupLayout struct {
+	// The ID of an existing account group. If not set, the account group is created.
+	ID string
+
+	// The name of the account group. Required to create an account group; if set for an existing
+	// account group, the account group is renamed.
+	Name string
+
+	// The IAM ID of the primary contact of the account group. Required to create an account group;
+	// if set for an existing account group, the primary contact is changed.
+	PrimaryContactIamID string
+
+	// The account groups whose parent is the account group.
+	AccountGroups []*AccountGroupLayout
+
+	// The IDs of the accounts whose parent is the account group.
+	Accounts []string
+}
+
+// RestructureStep : A change made by a restructure plan.
+type RestructureStep struct {
+	// The change: "create_account_group", "update_account_group" or "move_account".
+	Action string
+
+	// The ID of the account group that is updated. For a created account group, the ID is set
+	// once the step is applied.
+	AccountGroupID string
+
+	// The ID of the account that is moved.
+	AccountID string
+
+	// The name of the account group that is created, or its new name.
+	Name string
+
+	// The IAM ID of the primary contact of the account group that is created, or its new primary contact.
+	PrimaryContactIamID string
+
+	// The ID of the current parent of the account that is moved.
+	FromParentID string
+
+	// The ID of the parent of the account group that is created, or of the new parent of the account that is moved.
+	// It is empty if the parent is created by an earlier step, ToParentStep, until that step is applied.
+	ToParentID string
+
+	// The step that creates the parent, if the parent is created by the plan.
+	ToParentStep *RestructureStep
+
+	toParentCRN     string
+	accountGroupCRN string
+}
+
+// String returns a description of the step.
+func (step *RestructureStep) String() string {
+	toParent := step.ToParentID
+	if toParent == "" && step.ToParentStep != nil {
+		toParent = "new account group '" + step.ToParentStep.Name + "'"
+	} else {
+		toParent = "'" + toParent + "'"
+	}
+	switch step.Action {
+	case RestructureStepActionCreateAccountGroupConst:
+		return fmt.Sprintf("create account group '%s' under %s", step.Name, toParent)
+	case RestructureStepActionUpdateAccountGroupConst:
+		msg := fmt.Sprintf("update account group '%s'", step.AccountGroupID)
+		if step.Name != "" {
+			msg += fmt.Sprintf(": set name to '%s'", step.Name)
+		}
+		if step.PrimaryContactIamID != "" {
+			if step.Name != "" {
+				msg += ", "
+			} else {
+				msg += ": "
+			}
+			msg += fmt.Sprintf("set primary contact to '%s'", step.PrimaryContactIamID)
+		}
+		return msg
+	default:
+		return fmt.Sprintf("move account '%s' from '%s' to %s", step.AccountID, step.FromParentID, toParent)
+	}
+}
+
+// RestructurePlan : The ordered changes that turn the current hierarchy of an enterprise into a desired one.
+// The account groups are created first, each after its parent, then the account groups are updated and
+// finally the accounts are moved.
+type RestructurePlan struct {
+	Steps []*RestructureStep
+}
+
+// PlanRestructure compares the current hierarchy of an enterprise, as returned by LoadEnterpriseTree, with
+// the desired one and returns the changes to make. An error is returned if the layout references an account
+// group or account that is not in the enterprise, references one of them more than once, or places an
+// existing account group under a different parent: account groups cannot be moved.
+func PlanRestructure(current *EnterpriseTree, desired *EnterpriseLayout) (*RestructurePlan, error) {
+	if current == nil || desired == nil {
+		return nil, core.SDKErrorf(nil, "the current tree and the desired layout must be specified", "restructure-missing-param", common.GetComponentInfo())
+	}
+	planner := &restructurePlanner{current: current, seen: make(map[string]bool)}
+	root := &restructureParent{id: current.Root.ID, crn: current.Root.CRN}
+	if err := planner.plan(root, desired.AccountGroups, desired.Accounts); err != nil {
+		return nil, err
+	}
+	steps := append(planner.creates, planner.updates...)
+	return &RestructurePlan{Steps: append(steps, planner.moves...)}, nil
+}
+
+// restructureParent : The parent of account groups and accounts in a layout: an existing entity, or an
+// account group created by a step.
+type restructureParent struct {
+	id     string
+	crn    string
+	create *RestructureStep
+}
+
+type restructurePlanner struct {
+	current *EnterpriseTree
+	seen    map[string]bool
+
+	creates []*RestructureStep
+	updates []*RestructureStep
+	moves   []*RestructureStep
+}
+
+func (planner *restructurePlanner) plan(parent *restructureParent, accountGroups []*AccountGroupLayout, accounts []string) error {
+	for _, layout := range accountGroups {
+		if layout == nil {
+			continue
+		}
+		var child *restructureParent
+		if layout.ID == "" {
+			if layout.Name == "" || layout.PrimaryContactIamID == "" {
+				return core.SDKErrorf(nil, fmt.Sprintf("the account group '%s' to create must have a name and a primary contact", layout.Name), "restructure-incomplete-account-group", common.GetComponentInfo())
+			}
+			step := &RestructureStep{
+				Action:              RestructureStepActionCreateAccountGroupConst,
+				Name:                layout.Name,
+				PrimaryContactIamID: layout.PrimaryContactIamID,
+				ToParentID:          parent.id,
+				ToParentStep:        parent.create,
+				toParentCRN:         parent.crn,
+			}
+			planner.creates = append(planner.creates, step)
+			child = &restructureParent{create: step}
+		} else {
+			node, err := planner.lookup(layout.ID, EnterpriseTreeNodeTypeAccountGroupConst)
+			if err != nil {
+				return err
+			}
+			if node.Parent.ID != parent.id {
+				return core.SDKErrorf(nil, fmt.Sprintf("the account group '%s' cannot be moved from '%s'", layout.ID, node.Parent.ID), "restructure-account-group-move", common.GetComponentInfo())
+			}
+			step := &RestructureStep{Action: RestructureStepActionUpdateAccountGroupConst, AccountGroupID: layout.ID}
+			if layout.Name != "" && layout.Name != node.Name {
+				step.Name = layout.Name
+			}
+			if layout.PrimaryContactIamID != "" && layout.PrimaryContactIamID != core.StringNilMapper(node.AccountGroup.PrimaryContactIamID) {
+				step.PrimaryContactIamID = layout.PrimaryContactIamID
+			}
+			if step.Name != "" || step.PrimaryContactIamID != "" {
+				planner.updates = append(planner.updates, step)
+			}
+			child = &restructureParent{id: node.ID, crn: node.CRN}
+		}
+		if err := planner.plan(child, layout.AccountGroups, layout.Accounts); err != nil {
+			return err
+		}
+	}
+
+	for _, accountID := range accounts {
+		node, err := planner.lookup(accountID, EnterpriseTreeNodeTypeAccountConst)
+		if err != nil {
+			return err
+		}
+		if parent.create == nil && node.Parent.ID == parent.id {
+			continue
+		}
+		planner.moves = append(planner.moves, &RestructureStep{
+			Action:       RestructureStepActionMoveAccountConst,
+			AccountID:    accountID,
+			FromParentID: node.Parent.ID,
+			ToParentID:   parent.id,
+			ToParentStep: parent.create,
+			toParentCRN:  parent.crn,
+		})
+	}
+	return nil
+}
+
+// lookup returns the node of the current tree of type "nodeType" identified by "id", which must appear only
+// once in the layout.
+func (planner *restructurePlanner) lookup(id string, nodeType string) (*EnterpriseTreeNode, error) {
+	node := planner.current.Node(id)
+	if node == nil || node.Type != nodeType {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the %s '%s' is not part of the enterprise", (&EnterpriseTreeNode{Type: nodeType}).typeName(), id), "restructure-unknown-entity", common.GetComponentInfo())
+	}
+	if planner.seen[id] {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the ID '%s' appears more than once in the layout", id), "restructure-duplicate-id", common.GetComponentInfo())
+	}
+	planner.seen[id] = true
+	return node, nil
+}
+
+// ApplyRestructurePlanOptions : The ApplyRestructurePlan options.
+type ApplyRestructurePlanOptions struct {
+	// If true, no change is made: the report lists the steps as if they had all been applied.
+	DryRun bool
+
+	// An optional callback invoked before each step is applied.
+	Progress func(step *RestructureStep)
+}
+
+// RestructureReport : The outcome of ApplyRestructurePlan.
+type RestructureReport struct {
+	// True if the plan was applied in dry-run mode.
+	DryRun bool
+
+	// The steps that were applied, in order.
+	Applied []*RestructureStep
+
+	// The step that failed, if any.
+	Failed *RestructureStep
+
+	// The steps that were not attempted because an earlier step failed.
+	NotAttempted []*RestructureStep
+}
+
+// ApplyRestructurePlan applies the steps of a plan returned by PlanRestructure in order, and stops at the first
+// step that fails. The report lists the steps that were applied, the step that failed and the steps that were not
+// attempted; it is returned along with the error of the failed step.
+func (enterpriseManagement *EnterpriseManagementV1) ApplyRestructurePlan(ctx context.Context, plan *RestructurePlan, opts *ApplyRestructurePlanOptions) (*RestructureReport, error) {
+	if plan == nil {
+		return nil, core.SDKErrorf(nil, "the plan must be specified", "restructure-missing-param", common.GetComponentInfo())
+	}
+	if opts == nil {
+		opts = &ApplyRestructurePlanOptions{}
+	}
+	report := &RestructureReport{DryRun: opts.DryRun}
+	for i, step := range plan.Steps {
+		if opts.Progress != nil {
+			opts.Progress(step)
+		}
+		if !opts.DryRun {
+			if err := enterpriseManagement.applyRestructureStep(ctx, step); err != nil {
+				report.Failed = step
+				report.NotAttempted = plan.Steps[i+1:]
+				return report, core.SDKErrorf(err, fmt.Sprintf("unable to %s: %s", step, err.Error()), "restructure-step-error", common.GetComponentInfo())
+			}
+		}
+		report.Applied = append(report.Applied, step)
+	}
+	return report, nil
+}
+
+func (enterpriseManagement *EnterpriseManagementV1) applyRestructureStep(ctx context.Context, step *RestructureStep) error {
+	toParentCRN := step.toParentCRN
+	if parent := step.ToParentStep; parent != nil {
+		if parent.AccountGroupID == "" {
+			return fmt.Errorf("the account group '%s' was not created", parent.Name)
+		}
+		if parent.accountGroupCRN == "" {
+			crn, err := enterpriseManagement.getAccountGroupCRN(ctx, parent.AccountGroupID)
+			if err != nil {
+				return err
+			}
+			parent.accountGroupCRN = crn
+		}
+		step.ToParentID = parent.AccountGroupID
+		toParentCRN = parent.accountGroupCRN
+	}
+
+	switch step.Action {
+	case RestructureStepActionCreateAccountGroupConst:
+		result, _, err := enterpriseManagement.CreateAccountGroupWithContext(ctx, enterpriseManagement.NewCreateAccountGroupOptions(toParentCRN, step.Name, step.PrimaryContactIamID))
+		if err != nil {
+			return err
+		}
+		step.AccountGroupID = core.StringNilMapper(result.AccountGroupID)
+		// The CRN of the new account group is only needed by the steps that create or move its children,
+		// which retrieve it again if this request fails: the account group was created either way.
+		step.accountGroupCRN, _ = enterpriseManagement.getAccountGroupCRN(ctx, step.AccountGroupID)
+		return nil
+	case RestructureStepActionUpdateAccountGroupConst:
+		options := enterpriseManagement.NewUpdateAccountGroupOptions(step.AccountGroupID)
+		if step.Name != "" {
+			options.SetName(step.Name)
+		}
+		if step.PrimaryContactIamID != "" {
+			options.SetPrimaryContactIamID(step.PrimaryContactIamID)
+		}
+		_, err := enterpriseManagement.UpdateAccountGroupWithContext(ctx, options)
+		return err
+	default:
+		_, err := enterpriseManagement.UpdateAccountWithContext(ctx, enterpriseManagement.NewUpdateAccountOptions(step.AccountID, toParentCRN))
+		return err
+	}
+}
+
+// getAccountGroupCRN returns the CRN of the account group identified by "accountGroupID".
+func (enterpriseManagement *EnterpriseManagementV1) getAccountGroupCRN(ctx context.Context, accountGroupID string) (string, error) {
+	accountGroup, _, err := enterpriseManagement.GetAccountGroupWithContext(ctx, enterpriseManagement.NewGetAccountGroupOptions(accountGroupID))
+	if err != nil {
+		return "", err
+	}
+	return core.StringNilMapper(accountGroup.CRN), nil
+}
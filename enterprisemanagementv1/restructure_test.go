@@ -0,0 +1,235 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Restructure`, func() {
+	const enterpriseCRN = "crn:v1:bluemix:public:enterprise::a/abc::enterprise:e1"
+	groupCRN := func(id string) string {
+		return "crn:v1:bluemix:public:enterprise::a/abc::account-group:" + id
+	}
+	account := func(id string, parent string) enterprisemanagementv1.Account {
+		return enterprisemanagementv1.Account{ID: core.StringPtr(id), CRN: core.StringPtr("crn:v1:bluemix:public:enterprise::a/abc::account:" + id), Name: core.StringPtr(id), Parent: core.StringPtr(parent)}
+	}
+
+	// e1 ─┬─ g1 ─┬─ g2 ─── a2
+	//     │      └─ a1
+	//     └─ a0
+	var current *enterprisemanagementv1.EnterpriseTree
+	BeforeEach(func() {
+		var err error
+		current, err = enterprisemanagementv1.NewEnterpriseTree(
+			&enterprisemanagementv1.Enterprise{ID: core.StringPtr("e1"), CRN: core.StringPtr(enterpriseCRN), Name: core.StringPtr("Example Corp")},
+			[]enterprisemanagementv1.AccountGroup{
+				{ID: core.StringPtr("g1"), CRN: core.StringPtr(groupCRN("g1")), Name: core.StringPtr("Engineering"), Parent: core.StringPtr(enterpriseCRN), PrimaryContactIamID: core.StringPtr("IBMid-1")},
+				{ID: core.StringPtr("g2"), CRN: core.StringPtr(groupCRN("g2")), Name: core.StringPtr("Team"), Parent: core.StringPtr(groupCRN("g1")), PrimaryContactIamID: core.StringPtr("IBMid-1")},
+			},
+			[]enterprisemanagementv1.Account{account("a0", enterpriseCRN), account("a1", groupCRN("g1")), account("a2", groupCRN("g2"))},
+		)
+		Expect(err).To(BeNil())
+	})
+
+	// e1 ─┬─ g1 (Research) ─┬─ g2 ─── a2
+	//     │            └─ Platform ─── SRE ─── a1
+	//     └─ a0
+	desired := func() *enterprisemanagementv1.EnterpriseLayout {
+		return &enterprisemanagementv1.EnterpriseLayout{
+			AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{{
+				ID:                  "g1",
+				Name:                "Research",
+				PrimaryContactIamID: "IBMid-1",
+				AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{
+					{ID: "g2", Accounts: []string{"a2"}},
+					{Name: "Platform", PrimaryContactIamID: "IBMid-2", AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{
+						{Name: "SRE", PrimaryContactIamID: "IBMid-2", Accounts: []string{"a1"}},
+					}},
+				},
+			}},
+			Accounts: []string{"a0"},
+		}
+	}
+	descriptions := func(steps []*enterprisemanagementv1.RestructureStep) (result []string) {
+		for _, step := range steps {
+			result = append(result, step.String())
+		}
+		return
+	}
+
+	Describe(`PlanRestructure`, func() {
+		It(`Orders the changes so that parents exist before their children`, func() {
+			plan, err := enterprisemanagementv1.PlanRestructure(current, desired())
+			Expect(err).To(BeNil())
+			Expect(descriptions(plan.Steps)).To(Equal([]string{
+				"create account group 'Platform' under 'g1'",
+				"create account group 'SRE' under new account group 'Platform'",
+				"update account group 'g1': set name to 'Research'",
+				"move account 'a1' from 'g1' to new account group 'SRE'",
+			}))
+			Expect(plan.Steps[1].ToParentStep).To(Equal(plan.Steps[0]))
+		})
+		It(`Returns no step if the hierarchy is already the desired one`, func() {
+			plan, err := enterprisemanagementv1.PlanRestructure(current, &enterprisemanagementv1.EnterpriseLayout{
+				AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{{ID: "g1", Name: "Engineering", Accounts: []string{"a1"}}},
+				Accounts:      []string{"a0"},
+			})
+			Expect(err).To(BeNil())
+			Expect(plan.Steps).To(BeEmpty())
+		})
+		It(`Rejects invalid layouts`, func() {
+			invalid := []*enterprisemanagementv1.EnterpriseLayout{
+				{AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{{ID: "g2"}}},
+				{Accounts: []string{"a9"}},
+				{Accounts: []string{"g1"}},
+				{Accounts: []string{"a0", "a0"}},
+				{AccountGroups: []*enterprisemanagementv1.AccountGroupLayout{{Name: "New"}}},
+			}
+			for _, layout := range invalid {
+				_, err := enterprisemanagementv1.PlanRestructure(current, layout)
+				Expect(err).ToNot(BeNil())
+			}
+			_, err := enterprisemanagementv1.PlanRestructure(current, nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ApplyRestructurePlan`, func() {
+		var testServer *httptest.Server
+		var service *enterprisemanagementv1.EnterpriseManagementV1
+		var requests []string
+		var failingName string
+		var failingGetID string
+		BeforeEach(func() {
+			requests = nil
+			failingName = ""
+			failingGetID = ""
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				var body map[string]interface{}
+				if req.Body != nil && req.Method != http.MethodGet {
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				}
+				requests = append(requests, req.Method+" "+req.URL.Path+" "+strings.TrimSpace(string(must(json.Marshal(body)))))
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == http.MethodPost && req.URL.Path == "/account-groups":
+					if body["name"] == failingName {
+						res.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					res.WriteHeader(http.StatusCreated)
+					Expect(json.NewEncoder(res).Encode(map[string]string{"account_group_id": "new-" + body["name"].(string)})).To(Succeed())
+				case req.Method == http.MethodGet:
+					id := strings.TrimPrefix(req.URL.Path, "/account-groups/")
+					if id == failingGetID {
+						failingGetID = ""
+						res.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					Expect(json.NewEncoder(res).Encode(map[string]string{"id": id, "crn": groupCRN(id)})).To(Succeed())
+				default:
+					res.WriteHeader(http.StatusAccepted)
+				}
+			}))
+			var err error
+			service, err = enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Applies the steps in order`, func() {
+			plan, err := enterprisemanagementv1.PlanRestructure(current, desired())
+			Expect(err).To(BeNil())
+			report, err := service.ApplyRestructurePlan(context.Background(), plan, nil)
+			Expect(err).To(BeNil())
+			Expect(report.Applied).To(Equal(plan.Steps))
+			Expect(report.Failed).To(BeNil())
+			Expect(requests).To(Equal([]string{
+				`POST /account-groups {"name":"Platform","parent":"` + groupCRN("g1") + `","primary_contact_iam_id":"IBMid-2"}`,
+				`GET /account-groups/new-Platform null`,
+				`POST /account-groups {"name":"SRE","parent":"` + groupCRN("new-Platform") + `","primary_contact_iam_id":"IBMid-2"}`,
+				`GET /account-groups/new-SRE null`,
+				`PATCH /account-groups/g1 {"name":"Research"}`,
+				`PATCH /accounts/a1 {"parent":"` + groupCRN("new-SRE") + `"}`,
+			}))
+			Expect(plan.Steps[3].String()).To(Equal("move account 'a1' from 'g1' to 'new-SRE'"))
+		})
+		It(`Makes no change in dry-run mode`, func() {
+			plan, err := enterprisemanagementv1.PlanRestructure(current, desired())
+			Expect(err).To(BeNil())
+			var progress []*enterprisemanagementv1.RestructureStep
+			report, err := service.ApplyRestructurePlan(context.Background(), plan, &enterprisemanagementv1.ApplyRestructurePlanOptions{
+				DryRun:   true,
+				Progress: func(step *enterprisemanagementv1.RestructureStep) { progress = append(progress, step) },
+			})
+			Expect(err).To(BeNil())
+			Expect(report.DryRun).To(BeTrue())
+			Expect(report.Applied).To(Equal(plan.Steps))
+			Expect(progress).To(Equal(plan.Steps))
+			Expect(requests).To(BeEmpty())
+		})
+		It(`Reports what was applied when a step fails`, func() {
+			failingName = "SRE"
+			plan, err := enterprisemanagementv1.PlanRestructure(current, desired())
+			Expect(err).To(BeNil())
+			report, err := service.ApplyRestructurePlan(context.Background(), plan, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("create account group 'SRE'"))
+			Expect(report.Applied).To(Equal(plan.Steps[:1]))
+			Expect(report.Failed).To(Equal(plan.Steps[1]))
+			Expect(report.NotAttempted).To(Equal(plan.Steps[2:]))
+			Expect(report.Applied[0].AccountGroupID).To(Equal("new-Platform"))
+			Expect(requests).To(HaveLen(3))
+		})
+		It(`Keeps the ID of a created account group when it cannot be retrieved`, func() {
+			failingGetID = "new-Platform"
+			plan, err := enterprisemanagementv1.PlanRestructure(current, desired())
+			Expect(err).To(BeNil())
+			report, err := service.ApplyRestructurePlan(context.Background(), plan, nil)
+			Expect(err).To(BeNil())
+			Expect(report.Applied).To(Equal(plan.Steps))
+			Expect(report.Applied[0].AccountGroupID).To(Equal("new-Platform"))
+			Expect(requests[:4]).To(Equal([]string{
+				`POST /account-groups {"name":"Platform","parent":"` + groupCRN("g1") + `","primary_contact_iam_id":"IBMid-2"}`,
+				`GET /account-groups/new-Platform null`,
+				`GET /account-groups/new-Platform null`,
+				`POST /account-groups {"name":"SRE","parent":"` + groupCRN("new-Platform") + `","primary_contact_iam_id":"IBMid-2"}`,
+			}))
+		})
+	})
+})
+
+func must(data []byte, err error) []byte {
+	Expect(err).To(BeNil())
+	return data
+}
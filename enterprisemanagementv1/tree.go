@@ -0,0 +1,404 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the EnterpriseTreeNode.Type property.
+// The type of an entity of an enterprise.
+const (
+	EnterpriseTreeNodeTypeEnterpriseConst   = "enterprise"
+	EnterpriseTreeNodeTypeAccountGroupConst = "account_group"
+	EnterpriseTreeNodeTypeAccountConst      = "account"
+)
+
+// EnterpriseTreeNode : An entity of an enterprise (the enterprise itself, an account group or an account)
+// in an EnterpriseTree.
+type EnterpriseTreeNode struct {
+	// The type of the entity: "enterprise", "account_group" or "account".
+	Type string `json:"type"`
+
+	// The ID of the entity.
+	ID string `json:"id"`
+
+	// The Cloud Resource Name (CRN) of the entity.
+	CRN string `json:"crn"`
+
+	// The name of the entity.
+	Name string `json:"name,omitempty"`
+
+	// The state of the entity.
+	State string `json:"state,omitempty"`
+
+	// The entity, according to its type. The other two fields are nil.
+	Enterprise   *Enterprise   `json:"-"`
+	AccountGroup *AccountGroup `json:"-"`
+	Account      *Account      `json:"-"`
+
+	// The parent of the entity, or nil for the root of the tree.
+	Parent *EnterpriseTreeNode `json:"-"`
+
+	// The account groups and accounts whose parent is the entity; account groups come first.
+	Children []*EnterpriseTreeNode `json:"children,omitempty"`
+}
+
+// IsAccount returns true if the node is an account.
+func (node *EnterpriseTreeNode) IsAccount() bool {
+	return node.Type == EnterpriseTreeNodeTypeAccountConst
+}
+
+// IsAccountGroup returns true if the node is an account group.
+func (node *EnterpriseTreeNode) IsAccountGroup() bool {
+	return node.Type == EnterpriseTreeNodeTypeAccountGroupConst
+}
+
+// typeName returns the type of the node in plain words, for messages.
+func (node *EnterpriseTreeNode) typeName() string {
+	return strings.ReplaceAll(node.Type, "_", " ")
+}
+
+// Depth returns the number of ancestors of the node in its tree.
+func (node *EnterpriseTreeNode) Depth() (depth int) {
+	for parent := node.Parent; parent != nil; parent = parent.Parent {
+		depth++
+	}
+	return
+}
+
+// Path returns the nodes from the root of the tree to the node, inclusive.
+func (node *EnterpriseTreeNode) Path() []*EnterpriseTreeNode {
+	path := make([]*EnterpriseTreeNode, node.Depth()+1)
+	for i, current := len(path)-1, node; current != nil; i, current = i-1, current.Parent {
+		path[i] = current
+	}
+	return path
+}
+
+// DepthFirst returns an iterator over the node and its descendants in depth-first pre-order:
+// each node is visited before its children.
+func (node *EnterpriseTreeNode) DepthFirst() iter.Seq[*EnterpriseTreeNode] {
+	return func(yield func(*EnterpriseTreeNode) bool) {
+		node.depthFirst(yield)
+	}
+}
+
+func (node *EnterpriseTreeNode) depthFirst(yield func(*EnterpriseTreeNode) bool) bool {
+	if !yield(node) {
+		return false
+	}
+	for _, child := range node.Children {
+		if !child.depthFirst(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// BreadthFirst returns an iterator over the node and its descendants in breadth-first order:
+// all the nodes of a level are visited before the nodes of the next level.
+func (node *EnterpriseTreeNode) BreadthFirst() iter.Seq[*EnterpriseTreeNode] {
+	return func(yield func(*EnterpriseTreeNode) bool) {
+		queue := []*EnterpriseTreeNode{node}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			if !yield(current) {
+				return
+			}
+			queue = append(queue, current.Children...)
+		}
+	}
+}
+
+// EnterpriseTree : The hierarchy of an enterprise, with the enterprise at the root, account groups as inner nodes
+// and accounts as leaves. A tree is built with NewEnterpriseTree or LoadEnterpriseTree, and should not be modified.
+type EnterpriseTree struct {
+	// The root of the tree: the enterprise, or an account group for a subtree.
+	Root *EnterpriseTreeNode
+
+	byID  map[string]*EnterpriseTreeNode
+	byCRN map[string]*EnterpriseTreeNode
+}
+
+// NewEnterpriseTree builds the tree of an enterprise from its account groups and accounts, which are attached to
+// the entity identified by their Parent CRN. An error is returned if an entity has no ID or CRN, if two entities
+// have the same ID, or if the parent of an entity is not the enterprise or one of the account groups.
+func NewEnterpriseTree(enterprise *Enterprise, accountGroups []AccountGroup, accounts []Account) (*EnterpriseTree, error) {
+	if enterprise == nil {
+		return nil, core.SDKErrorf(nil, "the enterprise must be specified", "tree-no-enterprise", common.GetComponentInfo())
+	}
+	tree := &EnterpriseTree{
+		byID:  make(map[string]*EnterpriseTreeNode),
+		byCRN: make(map[string]*EnterpriseTreeNode),
+	}
+	parents := make(map[*EnterpriseTreeNode]string)
+
+	add := func(node *EnterpriseTreeNode) error {
+		if node.ID == "" || node.CRN == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("the %s '%s' has no ID or CRN", node.typeName(), node.Name), "tree-missing-id", common.GetComponentInfo())
+		}
+		if _, ok := tree.byID[node.ID]; ok {
+			return core.SDKErrorf(nil, fmt.Sprintf("the ID '%s' appears more than once in the enterprise", node.ID), "tree-duplicate-id", common.GetComponentInfo())
+		}
+		tree.byID[node.ID] = node
+		tree.byCRN[node.CRN] = node
+		return nil
+	}
+
+	tree.Root = &EnterpriseTreeNode{
+		Type:       EnterpriseTreeNodeTypeEnterpriseConst,
+		ID:         core.StringNilMapper(enterprise.ID),
+		CRN:        core.StringNilMapper(enterprise.CRN),
+		Name:       core.StringNilMapper(enterprise.Name),
+		State:      core.StringNilMapper(enterprise.State),
+		Enterprise: enterprise,
+	}
+	if err := add(tree.Root); err != nil {
+		return nil, err
+	}
+	var nodes []*EnterpriseTreeNode
+	for i := range accountGroups {
+		accountGroup := &accountGroups[i]
+		node := &EnterpriseTreeNode{
+			Type:         EnterpriseTreeNodeTypeAccountGroupConst,
+			ID:           core.StringNilMapper(accountGroup.ID),
+			CRN:          core.StringNilMapper(accountGroup.CRN),
+			Name:         core.StringNilMapper(accountGroup.Name),
+			State:        core.StringNilMapper(accountGroup.State),
+			AccountGroup: accountGroup,
+		}
+		if err := add(node); err != nil {
+			return nil, err
+		}
+		parents[node] = core.StringNilMapper(accountGroup.Parent)
+		nodes = append(nodes, node)
+	}
+	for i := range accounts {
+		account := &accounts[i]
+		node := &EnterpriseTreeNode{
+			Type:    EnterpriseTreeNodeTypeAccountConst,
+			ID:      core.StringNilMapper(account.ID),
+			CRN:     core.StringNilMapper(account.CRN),
+			Name:    core.StringNilMapper(account.Name),
+			State:   core.StringNilMapper(account.State),
+			Account: account,
+		}
+		if err := add(node); err != nil {
+			return nil, err
+		}
+		parents[node] = core.StringNilMapper(account.Parent)
+		nodes = append(nodes, node)
+	}
+
+	for _, node := range nodes {
+		parent := tree.byCRN[parents[node]]
+		if parent == nil || parent.IsAccount() {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("the parent '%s' of the %s '%s' is not the enterprise or one of its account groups", parents[node], node.typeName(), node.ID), "tree-unknown-parent", common.GetComponentInfo())
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Account groups whose parents form a cycle are not reachable from the enterprise.
+	reachable := 0
+	for range tree.Root.DepthFirst() {
+		reachable++
+	}
+	if reachable != len(tree.byID) {
+		return nil, core.SDKErrorf(nil, "the parents of the account groups of the enterprise form a cycle", "tree-cycle", common.GetComponentInfo())
+	}
+	return tree, nil
+}
+
+// LoadEnterpriseTree retrieves the enterprise identified by "enterpriseID" with its account groups and accounts,
+// and returns its tree.
+func (enterpriseManagement *EnterpriseManagementV1) LoadEnterpriseTree(ctx context.Context, enterpriseID string) (*EnterpriseTree, error) {
+	enterprise, _, err := enterpriseManagement.GetEnterpriseWithContext(ctx, enterpriseManagement.NewGetEnterpriseOptions(enterpriseID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "tree-get-enterprise-error")
+	}
+
+	var accountGroups []AccountGroup
+	accountGroupsPager, err := enterpriseManagement.NewAccountGroupsPager(&ListAccountGroupsOptions{EnterpriseID: &enterpriseID})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "tree-list-account-groups-error")
+	}
+	for accountGroup, err := range accountGroupsPager.Items(ctx) {
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "tree-list-account-groups-error")
+		}
+		accountGroups = append(accountGroups, accountGroup)
+	}
+
+	var accounts []Account
+	accountsPager, err := enterpriseManagement.NewAccountsPager(&ListAccountsOptions{EnterpriseID: &enterpriseID})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "tree-list-accounts-error")
+	}
+	for account, err := range accountsPager.Items(ctx) {
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "tree-list-accounts-error")
+		}
+		accounts = append(accounts, account)
+	}
+
+	return NewEnterpriseTree(enterprise, accountGroups, accounts)
+}
+
+// Node returns the node of the entity identified by "id", or nil if the entity is not in the tree.
+func (tree *EnterpriseTree) Node(id string) *EnterpriseTreeNode {
+	return tree.byID[id]
+}
+
+// NodeByCRN returns the node of the entity identified by "crn", or nil if the entity is not in the tree.
+func (tree *EnterpriseTree) NodeByCRN(crn string) *EnterpriseTreeNode {
+	return tree.byCRN[crn]
+}
+
+// Len returns the number of nodes of the tree.
+func (tree *EnterpriseTree) Len() int {
+	return len(tree.byID)
+}
+
+// DepthFirst returns an iterator over all the nodes of the tree in depth-first pre-order.
+func (tree *EnterpriseTree) DepthFirst() iter.Seq[*EnterpriseTreeNode] {
+	return tree.Root.DepthFirst()
+}
+
+// BreadthFirst returns an iterator over all the nodes of the tree in breadth-first order.
+func (tree *EnterpriseTree) BreadthFirst() iter.Seq[*EnterpriseTreeNode] {
+	return tree.Root.BreadthFirst()
+}
+
+// Accounts returns the account nodes of the tree in depth-first order.
+func (tree *EnterpriseTree) Accounts() (accounts []*EnterpriseTreeNode) {
+	for node := range tree.DepthFirst() {
+		if node.IsAccount() {
+			accounts = append(accounts, node)
+		}
+	}
+	return
+}
+
+// AccountGroupsContaining returns the account groups that contain, directly or indirectly, the entity identified
+// by "id", from the outermost to the innermost. It returns false if the entity is not in the tree.
+func (tree *EnterpriseTree) AccountGroupsContaining(id string) ([]*EnterpriseTreeNode, bool) {
+	node := tree.byID[id]
+	if node == nil {
+		return nil, false
+	}
+	var accountGroups []*EnterpriseTreeNode
+	for _, ancestor := range node.Path() {
+		if ancestor != node && ancestor.IsAccountGroup() {
+			accountGroups = append(accountGroups, ancestor)
+		}
+	}
+	return accountGroups, true
+}
+
+// Subtree returns a copy of the subtree rooted at the entity identified by "id", or nil if the entity is not
+// in the tree.
+func (tree *EnterpriseTree) Subtree(id string) *EnterpriseTree {
+	node := tree.byID[id]
+	if node == nil {
+		return nil
+	}
+	return tree.copy(node, func(*EnterpriseTreeNode) bool { return true })
+}
+
+// Filter returns a copy of the tree that contains the nodes for which "keep" returns true, along with their
+// ancestors so that the copy remains connected. The root is always part of the copy.
+func (tree *EnterpriseTree) Filter(keep func(node *EnterpriseTreeNode) bool) *EnterpriseTree {
+	kept := make(map[*EnterpriseTreeNode]bool)
+	for node := range tree.DepthFirst() {
+		if keep(node) {
+			for current := node; current != nil && !kept[current]; current = current.Parent {
+				kept[current] = true
+			}
+		}
+	}
+	return tree.copy(tree.Root, func(node *EnterpriseTreeNode) bool { return kept[node] })
+}
+
+// copy returns a tree made of copies of "root" and of its descendants for which "keep" returns true.
+func (tree *EnterpriseTree) copy(root *EnterpriseTreeNode, keep func(*EnterpriseTreeNode) bool) *EnterpriseTree {
+	result := &EnterpriseTree{
+		byID:  make(map[string]*EnterpriseTreeNode),
+		byCRN: make(map[string]*EnterpriseTreeNode),
+	}
+	var copyNode func(node *EnterpriseTreeNode, parent *EnterpriseTreeNode) *EnterpriseTreeNode
+	copyNode = func(node *EnterpriseTreeNode, parent *EnterpriseTreeNode) *EnterpriseTreeNode {
+		nodeCopy := *node
+		nodeCopy.Parent = parent
+		nodeCopy.Children = nil
+		result.byID[nodeCopy.ID] = &nodeCopy
+		result.byCRN[nodeCopy.CRN] = &nodeCopy
+		for _, child := range node.Children {
+			if keep(child) {
+				nodeCopy.Children = append(nodeCopy.Children, copyNode(child, &nodeCopy))
+			}
+		}
+		return &nodeCopy
+	}
+	result.Root = copyNode(root, nil)
+	return result
+}
+
+// MarshalJSON returns the JSON encoding of the tree: its root, with the children of each node nested in
+// its "children" property.
+func (tree *EnterpriseTree) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tree.Root)
+}
+
+// WriteDOT writes the tree to "w" as a Graphviz DOT digraph, with an edge from each node to each of its children.
+func (tree *EnterpriseTree) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph enterprise {\n")
+	for node := range tree.DepthFirst() {
+		shape := "ellipse"
+		switch node.Type {
+		case EnterpriseTreeNodeTypeEnterpriseConst:
+			shape = "box3d"
+		case EnterpriseTreeNodeTypeAccountGroupConst:
+			shape = "folder"
+		}
+		label := node.Name
+		if label == "" {
+			label = node.ID
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", strconv.Quote(node.ID), strconv.Quote(label), shape)
+	}
+	for node := range tree.DepthFirst() {
+		for _, child := range node.Children {
+			fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(node.ID), strconv.Quote(child.ID))
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
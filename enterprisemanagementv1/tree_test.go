@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package enterprisemanagementv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnterpriseTree`, func() {
+	const enterpriseCRN = "crn:v1:bluemix:public:enterprise::a/abc::enterprise:e1"
+	groupCRN := func(id string) string {
+		return "crn:v1:bluemix:public:enterprise::a/abc::account-group:" + id
+	}
+	accountCRN := func(id string) string {
+		return "crn:v1:bluemix:public:enterprise::a/abc::account:" + id
+	}
+	accountGroup := func(id string, name string, parent string) enterprisemanagementv1.AccountGroup {
+		return enterprisemanagementv1.AccountGroup{ID: core.StringPtr(id), CRN: core.StringPtr(groupCRN(id)), Name: core.StringPtr(name), Parent: core.StringPtr(parent)}
+	}
+	account := func(id string, name string, parent string) enterprisemanagementv1.Account {
+		return enterprisemanagementv1.Account{ID: core.StringPtr(id), CRN: core.StringPtr(accountCRN(id)), Name: core.StringPtr(name), Parent: core.StringPtr(parent)}
+	}
+	enterprise := &enterprisemanagementv1.Enterprise{ID: core.StringPtr("e1"), CRN: core.StringPtr(enterpriseCRN), Name: core.StringPtr("Example Corp")}
+
+	// e1 ─┬─ g1 ─┬─ g2 ─── a2
+	//     │      └─ a1
+	//     └─ a0
+	accountGroups := func() []enterprisemanagementv1.AccountGroup {
+		return []enterprisemanagementv1.AccountGroup{
+			accountGroup("g2", "Team", groupCRN("g1")),
+			accountGroup("g1", "Engineering", enterpriseCRN),
+		}
+	}
+	accounts := func() []enterprisemanagementv1.Account {
+		return []enterprisemanagementv1.Account{
+			account("a0", "Finance", enterpriseCRN),
+			account("a1", "Tools", groupCRN("g1")),
+			account("a2", "Service \"A\"", groupCRN("g2")),
+		}
+	}
+	ids := func(nodes []*enterprisemanagementv1.EnterpriseTreeNode) (result []string) {
+		for _, node := range nodes {
+			result = append(result, node.ID)
+		}
+		return
+	}
+
+	Describe(`NewEnterpriseTree`, func() {
+		It(`Builds the hierarchy from the parent CRNs`, func() {
+			tree, err := enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), accounts())
+			Expect(err).To(BeNil())
+			Expect(tree.Len()).To(Equal(6))
+			Expect(tree.Root.Type).To(Equal(enterprisemanagementv1.EnterpriseTreeNodeTypeEnterpriseConst))
+			Expect(tree.Root.Enterprise).To(Equal(enterprise))
+			Expect(ids(tree.Root.Children)).To(Equal([]string{"g1", "a0"}))
+			Expect(ids(tree.Node("g1").Children)).To(Equal([]string{"g2", "a1"}))
+			Expect(tree.Node("g1").Parent).To(Equal(tree.Root))
+			Expect(*tree.Node("a2").Account.Name).To(Equal(`Service "A"`))
+			Expect(tree.Node("a2").Depth()).To(Equal(3))
+			Expect(tree.NodeByCRN(groupCRN("g2"))).To(Equal(tree.Node("g2")))
+			Expect(tree.Node("unknown")).To(BeNil())
+		})
+		It(`Rejects inconsistent hierarchies`, func() {
+			_, err := enterprisemanagementv1.NewEnterpriseTree(nil, accountGroups(), accounts())
+			Expect(err).ToNot(BeNil())
+
+			_, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), append(accounts(), account("a9", "Orphan", groupCRN("g9"))))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("a9"))
+
+			_, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), append(accounts(), account("a9", "Nested", accountCRN("a0"))))
+			Expect(err).ToNot(BeNil())
+
+			_, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), append(accounts(), account("a1", "Duplicate", enterpriseCRN)))
+			Expect(err).ToNot(BeNil())
+
+			_, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), append(accounts(), enterprisemanagementv1.Account{Name: core.StringPtr("Anonymous")}))
+			Expect(err).ToNot(BeNil())
+
+			cycle := []enterprisemanagementv1.AccountGroup{
+				accountGroup("g1", "Engineering", enterpriseCRN),
+				accountGroup("g3", "Left", groupCRN("g4")),
+				accountGroup("g4", "Right", groupCRN("g3")),
+			}
+			_, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, cycle, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("cycle"))
+		})
+	})
+
+	Describe(`Traversal`, func() {
+		var tree *enterprisemanagementv1.EnterpriseTree
+		BeforeEach(func() {
+			var err error
+			tree, err = enterprisemanagementv1.NewEnterpriseTree(enterprise, accountGroups(), accounts())
+			Expect(err).To(BeNil())
+		})
+		It(`Walks the tree depth-first and breadth-first`, func() {
+			var depthFirst, breadthFirst []*enterprisemanagementv1.EnterpriseTreeNode
+			for node := range tree.DepthFirst() {
+				depthFirst = append(depthFirst, node)
+			}
+			for node := range tree.BreadthFirst() {
+				breadthFirst = append(breadthFirst, node)
+			}
+			Expect(ids(depthFirst)).To(Equal([]string{"e1", "g1", "g2", "a2", "a1", "a0"}))
+			Expect(ids(breadthFirst)).To(Equal([]string{"e1", "g1", "a0", "g2", "a1", "a2"}))
+			Expect(ids(tree.Accounts())).To(Equal([]string{"a2", "a1", "a0"}))
+
+			var visited []*enterprisemanagementv1.EnterpriseTreeNode
+			for node := range tree.Node("g1").BreadthFirst() {
+				visited = append(visited, node)
+				if node.ID == "g2" {
+					break
+				}
+			}
+			Expect(ids(visited)).To(Equal([]string{"g1", "g2"}))
+		})
+		It(`Finds the account groups that contain an entity`, func() {
+			Expect(ids(tree.Node("a2").Path())).To(Equal([]string{"e1", "g1", "g2", "a2"}))
+
+			groups, ok := tree.AccountGroupsContaining("a2")
+			Expect(ok).To(BeTrue())
+			Expect(ids(groups)).To(Equal([]string{"g1", "g2"}))
+			groups, ok = tree.AccountGroupsContaining("a0")
+			Expect(ok).To(BeTrue())
+			Expect(groups).To(BeEmpty())
+			_, ok = tree.AccountGroupsContaining("unknown")
+			Expect(ok).To(BeFalse())
+		})
+		It(`Extracts subtrees`, func() {
+			subtree := tree.Subtree("g1")
+			Expect(subtree.Root.ID).To(Equal("g1"))
+			Expect(subtree.Root.Parent).To(BeNil())
+			Expect(subtree.Len()).To(Equal(4))
+			Expect(subtree.Node("a0")).To(BeNil())
+			Expect(subtree.Node("a2").Depth()).To(Equal(2))
+			Expect(tree.Node("a2").Depth()).To(Equal(3))
+			Expect(tree.Subtree("unknown")).To(BeNil())
+
+			filtered := tree.Filter(func(node *enterprisemanagementv1.EnterpriseTreeNode) bool { return node.ID == "a2" })
+			Expect(filtered.Len()).To(Equal(4))
+			Expect(ids(filtered.Node("a2").Path())).To(Equal([]string{"e1", "g1", "g2", "a2"}))
+			Expect(ids(filtered.Node("g1").Children)).To(Equal([]string{"g2"}))
+			Expect(ids(tree.Node("g1").Children)).To(Equal([]string{"g2", "a1"}))
+		})
+		It(`Exports the tree to JSON and DOT`, func() {
+			data, err := json.Marshal(tree.Subtree("g2"))
+			Expect(err).To(BeNil())
+			Expect(data).To(MatchJSON(fmt.Sprintf(`{"type": "account_group", "id": "g2", "crn": %q, "name": "Team", "children": [
+				{"type": "account", "id": "a2", "crn": %q, "name": "Service \"A\""}]}`, groupCRN("g2"), accountCRN("a2"))))
+
+			var buffer bytes.Buffer
+			Expect(tree.Subtree("g1").WriteDOT(&buffer)).To(Succeed())
+			Expect(buffer.String()).To(Equal(`digraph enterprise {
+  "g1" [label="Engineering", shape=folder];
+  "g2" [label="Team", shape=folder];
+  "a2" [label="Service \"A\"", shape=ellipse];
+  "a1" [label="Tools", shape=ellipse];
+  "g1" -> "g2";
+  "g1" -> "a1";
+  "g2" -> "a2";
+}
+`))
+		})
+	})
+
+	Describe(`LoadEnterpriseTree`, func() {
+		var testServer *httptest.Server
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Loads all the pages of account groups and accounts`, func() {
+			requests := 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				requests++
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.Path {
+				case "/enterprises/e1":
+					Expect(json.NewEncoder(res).Encode(enterprise)).To(Succeed())
+				case "/account-groups":
+					Expect(req.URL.Query().Get("enterprise_id")).To(Equal("e1"))
+					Expect(json.NewEncoder(res).Encode(map[string]interface{}{"resources": accountGroups()})).To(Succeed())
+				case "/accounts":
+					Expect(req.URL.Query().Get("enterprise_id")).To(Equal("e1"))
+					page := map[string]interface{}{"resources": accounts()[:2], "next_url": "/accounts?next_docid=2"}
+					if req.URL.Query().Get("next_docid") == "2" {
+						page = map[string]interface{}{"resources": accounts()[2:]}
+					}
+					Expect(json.NewEncoder(res).Encode(page)).To(Succeed())
+				default:
+					res.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			service, err := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			tree, err := service.LoadEnterpriseTree(context.Background(), "e1")
+			Expect(err).To(BeNil())
+			Expect(requests).To(Equal(4))
+			Expect(tree.Len()).To(Equal(6))
+			Expect(ids(tree.Node("g2").Children)).To(Equal([]string{"a2"}))
+
+			_, err = service.LoadEnterpriseTree(context.Background(), "e2")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
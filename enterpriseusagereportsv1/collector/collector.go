@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package collector collects the usage of all the accounts of an enterprise concurrently, and rolls it up to
+// the account groups and the enterprise:
+//
+//	c, err := collector.New(enterpriseManagementService, &collector.Options{
+//		Month:             "2025-05",
+//		Fetcher:           collector.AccountUsageFetcher(usageReportsService),
+//		Concurrency:       8,
+//		RequestsPerSecond: 20,
+//	})
+//	result, err := c.Collect(ctx, enterpriseID)
+//	fmt.Println(result.Root.Usage.BillableCost, len(result.Failures))
+//
+// The usage of each account is retrieved by a Fetcher, which calls either usagereportsv4.GetAccountUsage or
+// enterpriseusagereportsv1.GetResourceUsageReport. A failure to retrieve the usage of an account does not abort
+// the collection: it is reported for the account, and the roll-ups of its ancestors only include the usage of
+// the accounts that succeeded.
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4/analysis"
+)
+
+// DefaultConcurrency is the number of accounts whose usage is retrieved concurrently when Options.Concurrency is
+// not set.
+const DefaultConcurrency = 4
+
+// The types of the nodes of an enterprise.
+const (
+	NodeTypeEnterprise   = "enterprise"
+	NodeTypeAccountGroup = "account-group"
+	NodeTypeAccount      = "account"
+)
+
+// Usage : The usage of an account, or the total usage of the accounts of an account group or enterprise, in a month.
+type Usage struct {
+	Month        string
+	CurrencyCode string
+
+	BillableCost         float64
+	NonBillableCost      float64
+	BillableRatedCost    float64
+	NonBillableRatedCost float64
+
+	// The detailed costs, if provided by the Fetcher. For an account group or enterprise, the costs of all
+	// the accounts that succeeded.
+	Costs analysis.CostTable
+}
+
+// add adds "other" to the usage.
+func (usage *Usage) add(other *Usage) {
+	if usage.CurrencyCode == "" {
+		usage.CurrencyCode = other.CurrencyCode
+	}
+	usage.BillableCost += other.BillableCost
+	usage.NonBillableCost += other.NonBillableCost
+	usage.BillableRatedCost += other.BillableRatedCost
+	usage.NonBillableRatedCost += other.NonBillableRatedCost
+	usage.Costs = append(usage.Costs, other.Costs...)
+}
+
+// Fetcher : A function that retrieves the usage of an account in a month (in the format yyyy-mm).
+type Fetcher func(ctx context.Context, accountID string, month string) (*Usage, error)
+
+// AccountUsageFetcher returns a Fetcher that retrieves the usage of an account with usagereportsv4.GetAccountUsage.
+// The Costs of the usage hold the cost of each metric of each plan.
+func AccountUsageFetcher(usageReports *usagereportsv4.UsageReportsV4) Fetcher {
+	return func(ctx context.Context, accountID string, month string) (*Usage, error) {
+		accountUsage, _, err := usageReports.GetAccountUsageWithContext(ctx, usageReports.NewGetAccountUsageOptions(accountID, month))
+		if err != nil {
+			return nil, err
+		}
+		usage := &Usage{
+			Month:        core.StringNilMapper(accountUsage.Month),
+			CurrencyCode: core.StringNilMapper(accountUsage.CurrencyCode),
+			Costs:        analysis.FromAccountUsage(accountUsage),
+		}
+		for _, entry := range usage.Costs {
+			if entry.Billable {
+				usage.BillableCost += entry.Cost
+				usage.BillableRatedCost += entry.RatedCost
+			} else {
+				usage.NonBillableCost += entry.Cost
+				usage.NonBillableRatedCost += entry.RatedCost
+			}
+		}
+		return usage, nil
+	}
+}
+
+// ResourceUsageReportFetcher returns a Fetcher that retrieves the usage of an account with
+// enterpriseusagereportsv1.GetResourceUsageReport. The Costs of the usage are not set.
+func ResourceUsageReportFetcher(enterpriseUsageReports *enterpriseusagereportsv1.EnterpriseUsageReportsV1) Fetcher {
+	return func(ctx context.Context, accountID string, month string) (*Usage, error) {
+		options := &enterpriseusagereportsv1.GetResourceUsageReportOptions{}
+		options.SetAccountID(accountID)
+		options.SetMonth(month)
+		pager, err := enterpriseUsageReports.NewGetResourceUsageReportPager(options)
+		if err != nil {
+			return nil, err
+		}
+		usage := &Usage{Month: month}
+		for report, err := range pager.Items(ctx) {
+			if err != nil {
+				return nil, err
+			}
+			usage.add(&Usage{
+				CurrencyCode:         core.StringNilMapper(report.CurrencyCode),
+				BillableCost:         derefFloat(report.BillableCost),
+				NonBillableCost:      derefFloat(report.NonBillableCost),
+				BillableRatedCost:    derefFloat(report.BillableRatedCost),
+				NonBillableRatedCost: derefFloat(report.NonBillableRatedCost),
+			})
+		}
+		return usage, nil
+	}
+}
+
+// Options : The Collector options.
+type Options struct {
+	// The month of the usage to collect, in the format yyyy-mm. Required.
+	Month string
+
+	// The function that retrieves the usage of an account. Required.
+	Fetcher Fetcher
+
+	// The maximum number of accounts whose usage is retrieved concurrently. Defaults to DefaultConcurrency.
+	Concurrency int
+
+	// The maximum number of usage retrievals started per second. If not set, the retrievals are not rate limited.
+	RequestsPerSecond float64
+}
+
+// Collector : Collects the usage of the accounts of an enterprise. It is created with New.
+type Collector struct {
+	enterpriseManagement *enterprisemanagementv1.EnterpriseManagementV1
+	month                string
+	fetcher              Fetcher
+	concurrency          int
+	interval             time.Duration
+}
+
+// Node : An entity of an enterprise (the enterprise, an account group or an account) and its usage.
+type Node struct {
+	ID   string
+	CRN  string
+	Name string
+
+	// NodeTypeEnterprise, NodeTypeAccountGroup or NodeTypeAccount.
+	Type string
+
+	// The account groups and accounts of an enterprise or account group.
+	Children []*Node
+
+	// The usage of an account, or the total usage of the accounts of an account group or enterprise whose usage
+	// was retrieved. Nil for an account whose usage could not be retrieved.
+	Usage *Usage
+
+	// The error that prevented the retrieval of the usage of an account.
+	Err error
+
+	// The number of accounts in the subtree of the node whose usage could not be retrieved.
+	FailedAccounts int
+}
+
+// Result : The usage of an enterprise.
+type Result struct {
+	// The enterprise, with its account groups and accounts.
+	Root *Node
+
+	// The errors that prevented the retrieval of the usage of accounts, by account ID.
+	Failures map[string]error
+}
+
+// New returns a Collector that walks enterprises with "enterpriseManagement".
+func New(enterpriseManagement *enterprisemanagementv1.EnterpriseManagementV1, opts *Options) (*Collector, error) {
+	if enterpriseManagement == nil {
+		return nil, errors.New("the enterprise management service must be specified")
+	}
+	if opts == nil || opts.Month == "" {
+		return nil, errors.New("the month must be specified")
+	}
+	if opts.Fetcher == nil {
+		return nil, errors.New("the fetcher must be specified")
+	}
+	c := &Collector{
+		enterpriseManagement: enterpriseManagement,
+		month:                opts.Month,
+		fetcher:              opts.Fetcher,
+		concurrency:          opts.Concurrency,
+	}
+	if c.concurrency <= 0 {
+		c.concurrency = DefaultConcurrency
+	}
+	if opts.RequestsPerSecond > 0 {
+		c.interval = time.Duration(float64(time.Second) / opts.RequestsPerSecond)
+	}
+	return c, nil
+}
+
+// Collect loads the account groups and accounts of the enterprise identified by "enterpriseID", retrieves the usage
+// of every account and rolls it up. An error is returned only if the enterprise cannot be loaded or if the Context
+// is cancelled or expires; the failure to retrieve the usage of an account is reported in the result.
+func (c *Collector) Collect(ctx context.Context, enterpriseID string) (*Result, error) {
+	root, accounts, err := c.load(ctx, enterpriseID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make(chan *Node)
+	var wg sync.WaitGroup
+	for i := 0; i < min(c.concurrency, max(len(accounts), 1)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for account := range jobs {
+				account.Usage, account.Err = c.fetcher(ctx, account.ID, c.month)
+				if account.Err != nil {
+					account.Usage = nil
+				} else if account.Usage == nil {
+					account.Usage = &Usage{Month: c.month}
+				}
+			}
+		}()
+	}
+
+	var ticker *time.Ticker
+	if c.interval > 0 {
+		ticker = time.NewTicker(c.interval)
+		defer ticker.Stop()
+	}
+	for i, account := range accounts {
+		if ticker != nil && i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-ticker.C:
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- account
+	}
+	close(jobs)
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("usage collection interrupted: %w", ctx.Err())
+	}
+
+	result := &Result{Root: root, Failures: make(map[string]error)}
+	rollUp(root, c.month, result.Failures)
+	return result, nil
+}
+
+// load returns the tree of the enterprise identified by "enterpriseID", and its accounts.
+func (c *Collector) load(ctx context.Context, enterpriseID string) (root *Node, accounts []*Node, err error) {
+	tree, err := c.enterpriseManagement.LoadEnterpriseTree(ctx, enterpriseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading enterprise '%s': %w", enterpriseID, err)
+	}
+	var convert func(node *enterprisemanagementv1.EnterpriseTreeNode) *Node
+	convert = func(node *enterprisemanagementv1.EnterpriseTreeNode) *Node {
+		result := &Node{ID: node.ID, CRN: node.CRN, Name: node.Name, Type: nodeTypes[node.Type]}
+		if node.IsAccount() {
+			accounts = append(accounts, result)
+		}
+		for _, child := range node.Children {
+			result.Children = append(result.Children, convert(child))
+		}
+		return result
+	}
+	return convert(tree.Root), accounts, nil
+}
+
+// nodeTypes maps the types of the nodes of an enterprisemanagementv1.EnterpriseTree to the types of the nodes
+// of a Result.
+var nodeTypes = map[string]string{
+	enterprisemanagementv1.EnterpriseTreeNodeTypeEnterpriseConst:   NodeTypeEnterprise,
+	enterprisemanagementv1.EnterpriseTreeNodeTypeAccountGroupConst: NodeTypeAccountGroup,
+	enterprisemanagementv1.EnterpriseTreeNodeTypeAccountConst:      NodeTypeAccount,
+}
+
+// rollUp sets the usage of the account groups and enterprise in the subtree of "node" to the total usage of
+// their accounts, and records the failures of the accounts in "failures".
+func rollUp(node *Node, month string, failures map[string]error) {
+	if node.Type == NodeTypeAccount {
+		if node.Err != nil {
+			node.FailedAccounts = 1
+			failures[node.ID] = node.Err
+		}
+		return
+	}
+	node.Usage = &Usage{Month: month}
+	for _, child := range node.Children {
+		rollUp(child, month, failures)
+		if child.Usage != nil {
+			node.Usage.add(child.Usage)
+		}
+		node.FailedAccounts += child.FailedAccounts
+	}
+}
+
+// derefFloat returns the value of "f", or 0 if it is nil.
+func derefFloat(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
@@ -0,0 +1,259 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/stretchr/testify/assert"
+)
+
+const enterpriseCRN = "crn:v1:bluemix:public:enterprise::a/enterprise-account::enterprise:e1"
+
+func groupCRN(id string) string {
+	return "crn:v1:bluemix:public:enterprise::a/enterprise-account::account-group:" + id
+}
+
+// testEnterprise serves an enterprise with the hierarchy
+//
+//	e1 ─┬─ a0
+//	    └─ g1 ─┬─ a1
+//	           └─ g2 ─┬─ a2
+//	                  └─ a3 (its usage cannot be retrieved)
+//
+// and the usage of its accounts, which is 10 times the number of the account.
+type testEnterprise struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	inFlight  int
+	maxFlight int
+	fetches   []time.Time
+}
+
+func newTestEnterprise(t *testing.T) *testEnterprise {
+	e := &testEnterprise{}
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("GET /enterprises/e1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"id": "e1", "crn": enterpriseCRN, "name": "Example Corp"})
+	})
+	mux.HandleFunc("GET /account-groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "e1", r.URL.Query().Get("enterprise_id"))
+		writeJSON(w, map[string]interface{}{"resources": []interface{}{
+			map[string]interface{}{"id": "g2", "crn": groupCRN("g2"), "name": "Team", "parent": groupCRN("g1")},
+			map[string]interface{}{"id": "g1", "crn": groupCRN("g1"), "name": "Engineering", "parent": enterpriseCRN},
+		}})
+	})
+	mux.HandleFunc("GET /accounts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "e1", r.URL.Query().Get("enterprise_id"))
+		account := func(id string, parent string) map[string]interface{} {
+			return map[string]interface{}{"id": id, "crn": "crn:v1:bluemix:public:enterprise::a/enterprise-account::account:" + id, "name": "Account " + id, "parent": parent}
+		}
+		writeJSON(w, map[string]interface{}{"resources": []interface{}{
+			account("a0", enterpriseCRN),
+			account("a1", groupCRN("g1")),
+			account("a2", groupCRN("g2")),
+			account("a3", groupCRN("g2")),
+		}})
+	})
+	usage := func(w http.ResponseWriter, accountID string, handler func(cost float64)) {
+		e.mu.Lock()
+		e.inFlight++
+		e.maxFlight = max(e.maxFlight, e.inFlight)
+		e.fetches = append(e.fetches, time.Now())
+		e.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		e.mu.Lock()
+		e.inFlight--
+		e.mu.Unlock()
+
+		if accountID == "a3" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var n float64
+		fmt.Sscanf(strings.TrimPrefix(accountID, "a"), "%g", &n)
+		handler(10 * n)
+	}
+	mux.HandleFunc("GET /v4/accounts/{account_id}/usage/{month}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2025-05", r.PathValue("month"))
+		usage(w, r.PathValue("account_id"), func(cost float64) {
+			writeJSON(w, map[string]interface{}{
+				"account_id": r.PathValue("account_id"), "month": "2025-05", "currency_code": "USD", "pricing_country": "USA",
+				"resources": []interface{}{map[string]interface{}{
+					"resource_id": "cloud-object-storage", "billable_cost": cost + 1, "billable_rated_cost": cost + 1, "non_billable_cost": 0, "non_billable_rated_cost": 0,
+					"discounts": []interface{}{},
+					"plans": []interface{}{
+						map[string]interface{}{"plan_id": "standard", "billable": true, "cost": cost, "rated_cost": cost, "discounts": []interface{}{},
+							"usage": []interface{}{map[string]interface{}{"metric": "STORAGE", "quantity": 1, "cost": cost, "rated_cost": cost, "discounts": []interface{}{}}}},
+						map[string]interface{}{"plan_id": "lite", "billable": false, "cost": 1, "rated_cost": 1, "discounts": []interface{}{}, "usage": []interface{}{}},
+					},
+				}},
+			})
+		})
+	})
+	mux.HandleFunc("GET /v1/resource-usage-reports", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2025-05", r.URL.Query().Get("month"))
+		usage(w, r.URL.Query().Get("account_id"), func(cost float64) {
+			writeJSON(w, map[string]interface{}{"reports": []interface{}{
+				map[string]interface{}{"currency_code": "USD", "billable_cost": cost, "billable_rated_cost": cost, "non_billable_cost": 1, "non_billable_rated_cost": 1},
+			}})
+		})
+	})
+	e.server = httptest.NewServer(mux)
+	return e
+}
+
+func (e *testEnterprise) enterpriseManagement(t *testing.T) *enterprisemanagementv1.EnterpriseManagementV1 {
+	service, err := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+		URL:           e.server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func (e *testEnterprise) usageReports(t *testing.T) *usagereportsv4.UsageReportsV4 {
+	service, err := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+		URL:           e.server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func TestCollect(t *testing.T) {
+	e := newTestEnterprise(t)
+	defer e.server.Close()
+
+	c, err := New(e.enterpriseManagement(t), &Options{Month: "2025-05", Fetcher: AccountUsageFetcher(e.usageReports(t)), Concurrency: 2})
+	assert.Nil(t, err)
+	result, err := c.Collect(context.Background(), "e1")
+	assert.Nil(t, err)
+
+	root := result.Root
+	assert.Equal(t, NodeTypeEnterprise, root.Type)
+	assert.Equal(t, "Example Corp", root.Name)
+	assert.Len(t, root.Children, 2)
+	g1 := root.Children[0]
+	assert.Equal(t, "g1", g1.ID)
+	assert.Equal(t, "a0", root.Children[1].ID)
+	assert.Len(t, g1.Children, 2)
+	g2 := g1.Children[0]
+	assert.Equal(t, "g2", g2.ID)
+	assert.Equal(t, "a1", g1.Children[1].ID)
+
+	a2 := g2.Children[0]
+	assert.Equal(t, 20.0, a2.Usage.BillableCost)
+	assert.Equal(t, 1.0, a2.Usage.NonBillableCost)
+	assert.Equal(t, "USD", a2.Usage.CurrencyCode)
+	assert.Len(t, a2.Usage.Costs, 2)
+
+	a3 := g2.Children[1]
+	assert.Nil(t, a3.Usage)
+	assert.NotNil(t, a3.Err)
+	assert.Equal(t, map[string]error{"a3": a3.Err}, result.Failures)
+
+	assert.Equal(t, 20.0, g2.Usage.BillableCost)
+	assert.Equal(t, 1, g2.FailedAccounts)
+	assert.Equal(t, 30.0, g1.Usage.BillableCost)
+	assert.Equal(t, 2.0, g1.Usage.NonBillableCost)
+	assert.Equal(t, 30.0, root.Usage.BillableCost)
+	assert.Equal(t, 3.0, root.Usage.NonBillableCost)
+	assert.Equal(t, "2025-05", root.Usage.Month)
+	assert.Equal(t, 1, root.FailedAccounts)
+	assert.Len(t, root.Usage.Costs, 6)
+
+	assert.Len(t, e.fetches, 4)
+	assert.LessOrEqual(t, e.maxFlight, 2)
+}
+
+func TestCollectWithResourceUsageReports(t *testing.T) {
+	e := newTestEnterprise(t)
+	defer e.server.Close()
+
+	enterpriseUsageReports, err := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+		URL:           e.server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	c, err := New(e.enterpriseManagement(t), &Options{Month: "2025-05", Fetcher: ResourceUsageReportFetcher(enterpriseUsageReports), Concurrency: 1})
+	assert.Nil(t, err)
+	result, err := c.Collect(context.Background(), "e1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 30.0, result.Root.Usage.BillableCost)
+	assert.Equal(t, 3.0, result.Root.Usage.NonBillableCost)
+	assert.Nil(t, result.Root.Usage.Costs)
+	assert.Len(t, result.Failures, 1)
+	assert.Equal(t, 1, e.maxFlight)
+}
+
+func TestCollectRateLimit(t *testing.T) {
+	e := newTestEnterprise(t)
+	defer e.server.Close()
+
+	c, err := New(e.enterpriseManagement(t), &Options{Month: "2025-05", Fetcher: AccountUsageFetcher(e.usageReports(t)), Concurrency: 4, RequestsPerSecond: 50})
+	assert.Nil(t, err)
+	_, err = c.Collect(context.Background(), "e1")
+	assert.Nil(t, err)
+	assert.Len(t, e.fetches, 4)
+	assert.GreaterOrEqual(t, e.fetches[3].Sub(e.fetches[0]), 55*time.Millisecond)
+}
+
+func TestCollectErrors(t *testing.T) {
+	e := newTestEnterprise(t)
+	defer e.server.Close()
+
+	_, err := New(nil, &Options{Month: "2025-05", Fetcher: AccountUsageFetcher(e.usageReports(t))})
+	assert.NotNil(t, err)
+	_, err = New(e.enterpriseManagement(t), &Options{Fetcher: AccountUsageFetcher(e.usageReports(t))})
+	assert.NotNil(t, err)
+	_, err = New(e.enterpriseManagement(t), &Options{Month: "2025-05"})
+	assert.NotNil(t, err)
+
+	c, err := New(e.enterpriseManagement(t), &Options{Month: "2025-05", Fetcher: AccountUsageFetcher(e.usageReports(t))})
+	assert.Nil(t, err)
+	_, err = c.Collect(context.Background(), "unknown")
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c, err = New(e.enterpriseManagement(t), &Options{Month: "2025-05", Fetcher: func(ctx context.Context, accountID string, month string) (*Usage, error) {
+		cancel()
+		return nil, ctx.Err()
+	}, Concurrency: 1})
+	assert.Nil(t, err)
+	_, err = c.Collect(ctx, "e1")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -779,3 +780,10 @@ func (pager *GetResourceUsageReportPager) GetNext() (page []ResourceUsageReport,
 func (pager *GetResourceUsageReportPager) GetAll() (allItems []ResourceUsageReport, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetResourceUsageReportPager) Items(ctx context.Context) iter.Seq2[ResourceUsageReport, error] {
+	return common.Items[ResourceUsageReport](ctx, pager)
+}
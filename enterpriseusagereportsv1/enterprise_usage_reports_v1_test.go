@@ -536,6 +536,35 @@ var _ = Describe(`EnterpriseUsageReportsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.Items successfully`, func() {
+				enterpriseUsageReportsService, serviceErr := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseUsageReportsService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &enterpriseusagereportsv1.GetResourceUsageReportOptions{
+					EnterpriseID: core.StringPtr("abc12340d4bf4e36b0423d209b286f24"),
+					AccountGroupID: core.StringPtr("def456a237b94b9a9238ef024e204c9f"),
+					AccountID: core.StringPtr("987abcba31834216b8c726a7dd9eb8d6"),
+					Children: core.BoolPtr(true),
+					Month: core.StringPtr("2019-06"),
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseUsageReportsService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterpriseusagereportsv1.ResourceUsageReport
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`Model constructor tests`, func() {
@@ -0,0 +1,51 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN parsing`, func() {
+	unmarshal := func(s string) map[string]json.RawMessage {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(s), &m)).To(BeNil())
+		return m
+	}
+
+	It(`Invoke ResultItem.ParseCRN() successfully`, func() {
+		var model *globalsearchv2.ResultItem
+		Expect(globalsearchv2.UnmarshalResultItem(unmarshal(`{"crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::"}`), &model)).To(BeNil())
+		result, err := model.ParseCRN()
+		Expect(err).To(BeNil())
+		Expect(result.ServiceName).To(Equal("cloud-object-storage"))
+		Expect(result.AccountID()).To(Equal("59bcbfa6ea2f006b4ed7094c1a08dcdd"))
+		Expect(result.String()).To(Equal("crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::"))
+
+		model.CRN = core.StringPtr("not-a-crn")
+		_, err = model.ParseCRN()
+		Expect(err).ToNot(BeNil())
+		model.CRN = nil
+		_, err = model.ParseCRN()
+		Expect(err).To(MatchError("CRN is not set"))
+	})
+})
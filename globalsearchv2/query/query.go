@@ -0,0 +1,309 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package query builds the Lucene-formatted query strings accepted by the Global Search
+// and Global Tagging services (globalsearchv2.SearchOptions.Query and
+// globaltaggingv1.QueryString.QueryString).
+//
+// Values are quoted and escaped as needed, so CRNs containing colons and tag values
+// containing spaces can be used as-is:
+//
+//	q := query.And(
+//		query.Family("resource_controller"),
+//		query.Region("us-south"),
+//		query.Tag("env:dev"),
+//		query.Not(query.CRN("crn:v1:bluemix:public:cloud-object-storage:global:a/123::")),
+//	)
+//	searchOptions.SetQuery(q.String())
+//
+// The same string can be used to select the resources for a tagging operation:
+//
+//	attachTagOptions.Query = &globaltaggingv1.QueryString{QueryString: core.StringPtr(q.String())}
+package query
+
+import (
+	"strings"
+)
+
+// Field names commonly used in Global Search queries.
+const (
+	FieldAccountID       = "account_id"
+	FieldCRN             = "crn"
+	FieldFamily          = "family"
+	FieldName            = "name"
+	FieldRegion          = "region"
+	FieldResourceGroupID = "resource_group_id"
+	FieldServiceName     = "service_name"
+	FieldTags            = "tags"
+	FieldType            = "type"
+)
+
+// Query is a node of a Lucene-formatted query.
+type Query interface {
+	// String returns the Lucene-formatted representation of the query.
+	String() string
+}
+
+// term matches a field against a single value.
+type term struct {
+	field string
+	value string
+}
+
+func (t term) String() string {
+	return Escape(t.field) + ":" + quote(t.value)
+}
+
+// wildcard matches a field against a pattern containing the '*' and '?' wildcards.
+type wildcard struct {
+	field   string
+	pattern string
+}
+
+func (w wildcard) String() string {
+	var b strings.Builder
+	for _, r := range w.pattern {
+		if r != '*' && r != '?' && isSpecial(r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return Escape(w.field) + ":" + b.String()
+}
+
+// exists matches documents in which a field has a value.
+type exists struct {
+	field string
+}
+
+func (e exists) String() string {
+	return "_exists_:" + Escape(e.field)
+}
+
+// raw is a query string that is used verbatim.
+type raw string
+
+func (r raw) String() string {
+	return string(r)
+}
+
+// none matches no document.
+type none struct{}
+
+func (none) String() string {
+	return "NOT *"
+}
+
+// boolean combines its operands with the AND or OR operator.
+type boolean struct {
+	operator string
+	operands []Query
+}
+
+func (b boolean) String() string {
+	parts := make([]string, 0, len(b.operands))
+	for _, operand := range b.operands {
+		parts = append(parts, group(operand))
+	}
+	return strings.Join(parts, " "+b.operator+" ")
+}
+
+// not negates its operand.
+type not struct {
+	operand Query
+}
+
+func (n not) String() string {
+	return "NOT " + group(n.operand)
+}
+
+// All returns a query that matches every document.
+func All() Query {
+	return raw("*")
+}
+
+// None returns a query that matches no document.
+func None() Query {
+	return none{}
+}
+
+// Raw returns a query that uses "s" verbatim, without any escaping.
+func Raw(s string) Query {
+	return raw(s)
+}
+
+// Field returns a query that matches documents whose "name" field is exactly "value".
+func Field(name string, value string) Query {
+	return term{field: name, value: value}
+}
+
+// Wildcard returns a query that matches documents whose "name" field matches "pattern",
+// in which '*' matches any sequence of characters and '?' matches a single character.
+// All other special characters in "pattern" are escaped.
+func Wildcard(name string, pattern string) Query {
+	return wildcard{field: name, pattern: pattern}
+}
+
+// Exists returns a query that matches documents in which the "name" field has a value.
+func Exists(name string) Query {
+	return exists{field: name}
+}
+
+// AccountID returns a query that matches resources in the specified account.
+func AccountID(accountID string) Query {
+	return Field(FieldAccountID, accountID)
+}
+
+// CRN returns a query that matches the resource with the specified CRN.
+func CRN(crn string) Query {
+	return Field(FieldCRN, crn)
+}
+
+// Family returns a query that matches resources of the specified family
+// (e.g. "resource_controller", "is", "containers").
+func Family(family string) Query {
+	return Field(FieldFamily, family)
+}
+
+// Name returns a query that matches resources with the specified name.
+func Name(name string) Query {
+	return Field(FieldName, name)
+}
+
+// Region returns a query that matches resources in the specified region.
+func Region(region string) Query {
+	return Field(FieldRegion, region)
+}
+
+// ResourceGroupID returns a query that matches resources in the specified resource group.
+func ResourceGroupID(resourceGroupID string) Query {
+	return Field(FieldResourceGroupID, resourceGroupID)
+}
+
+// ServiceName returns a query that matches resources of the specified service.
+func ServiceName(serviceName string) Query {
+	return Field(FieldServiceName, serviceName)
+}
+
+// Tag returns a query that matches resources to which the specified tag
+// (e.g. "env:dev" or "cost center") is attached.
+func Tag(tag string) Query {
+	return Field(FieldTags, tag)
+}
+
+// Type returns a query that matches resources of the specified type
+// (e.g. "resource-instance", "k8-cluster").
+func Type(resourceType string) Query {
+	return Field(FieldType, resourceType)
+}
+
+// And returns a query that matches documents matched by all of "queries".
+// If no queries are specified, the result matches every document.
+func And(queries ...Query) Query {
+	return combine("AND", queries)
+}
+
+// Or returns a query that matches documents matched by any of "queries".
+// If no queries are specified, the result matches no document.
+func Or(queries ...Query) Query {
+	return combine("OR", queries)
+}
+
+// Not returns a query that matches documents not matched by "q".
+func Not(q Query) Query {
+	return not{operand: q}
+}
+
+// AnyOf returns a query that matches documents whose "name" field is any of "values".
+// If no values are specified, the result matches no document.
+func AnyOf(name string, values ...string) Query {
+	queries := make([]Query, 0, len(values))
+	for _, value := range values {
+		queries = append(queries, Field(name, value))
+	}
+	return Or(queries...)
+}
+
+// combine returns the query that combines "queries" with "operator". The nil queries are ignored, and so are the
+// queries that match no document in a disjunction, while they make a conjunction match no document.
+func combine(operator string, queries []Query) Query {
+	operands := make([]Query, 0, len(queries))
+	for _, q := range queries {
+		_, isNone := q.(none)
+		if isNone && operator == "AND" {
+			return None()
+		}
+		if q != nil && !isNone {
+			operands = append(operands, q)
+		}
+	}
+	switch len(operands) {
+	case 0:
+		if operator == "OR" {
+			return None()
+		}
+		return All()
+	case 1:
+		return operands[0]
+	default:
+		return boolean{operator: operator, operands: operands}
+	}
+}
+
+// group returns the string form of "q", enclosed in parentheses if it is a compound query or a raw query string,
+// whose operators could otherwise change the precedence of the enclosing query.
+func group(q Query) string {
+	switch q.(type) {
+	case boolean, raw, none:
+		return "(" + q.String() + ")"
+	}
+	return q.String()
+}
+
+// Escape returns "s" with every Lucene special character preceded by a backslash,
+// so that it can be used as a single unquoted term.
+func Escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if isSpecial(r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// quote returns "s" as a Lucene phrase, escaping any quotes and backslashes within it.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func isSpecial(r rune) bool {
+	switch r {
+	case '+', '-', '=', '&', '|', '>', '<', '!', '(', ')', '{', '}', '[', ']', '^', '"', '~', '*', '?', ':', '\\', '/', ' ', '\t':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, `family:"resource_controller"`, Family("resource_controller").String())
+	assert.Equal(t, `type:"resource-instance"`, Type("resource-instance").String())
+	assert.Equal(t, `region:"us-south"`, Region("us-south").String())
+	assert.Equal(t, `tags:"cost center"`, Tag("cost center").String())
+	assert.Equal(t, `crn:"crn:v1:bluemix:public:cloud-object-storage:global:a/123::"`,
+		CRN("crn:v1:bluemix:public:cloud-object-storage:global:a/123::").String())
+	assert.Equal(t, `name:"say \"hi\" \\ bye"`, Name(`say "hi" \ bye`).String())
+	assert.Equal(t, `doc.state:"active"`, Field("doc.state", "active").String())
+}
+
+func TestWildcard(t *testing.T) {
+	assert.Equal(t, `name:my\-app*`, Wildcard(FieldName, "my-app*").String())
+	assert.Equal(t, `tags:env\:de?`, Wildcard(FieldTags, "env:de?").String())
+	assert.Equal(t, `crn:crn\:v1\:bluemix\:public\:kms*`, Wildcard(FieldCRN, "crn:v1:bluemix:public:kms*").String())
+}
+
+func TestExists(t *testing.T) {
+	assert.Equal(t, `_exists_:service_name`, Exists(FieldServiceName).String())
+}
+
+func TestBoolean(t *testing.T) {
+	q := And(
+		Family("resource_controller"),
+		Or(Region("us-south"), Region("us-east")),
+		Not(Tag("env:dev")),
+	)
+	assert.Equal(t, `family:"resource_controller" AND (region:"us-south" OR region:"us-east") AND NOT tags:"env:dev"`, q.String())
+
+	q = Not(And(Type("resource-instance"), Exists(FieldTags)))
+	assert.Equal(t, `NOT (type:"resource-instance" AND _exists_:tags)`, q.String())
+
+	assert.Equal(t, `region:"us-south" OR region:"eu-de"`, AnyOf(FieldRegion, "us-south", "eu-de").String())
+
+	// Raw query strings are grouped so that their operators do not change the precedence.
+	assert.Equal(t, `(a OR b) AND x:"y"`, And(Raw("a OR b"), Field("x", "y")).String())
+	assert.Equal(t, `NOT (a OR b)`, Not(Raw("a OR b")).String())
+	assert.Equal(t, `a OR b`, And(Raw("a OR b")).String())
+}
+
+func TestBooleanDegenerate(t *testing.T) {
+	assert.Equal(t, `*`, And().String())
+	assert.Equal(t, `NOT *`, Or(nil).String())
+	assert.Equal(t, `NOT *`, Or().String())
+	assert.Equal(t, `NOT *`, AnyOf(FieldTags).String())
+	assert.Equal(t, `region:"us-south"`, Or(Region("us-south"), None()).String())
+	assert.Equal(t, `NOT *`, And(Region("us-south"), AnyOf(FieldTags)).String())
+	assert.Equal(t, `family:"is" AND NOT (NOT *)`, And(Family("is"), Not(None())).String())
+	assert.Equal(t, `region:"us-south"`, And(Region("us-south"), nil).String())
+	assert.Equal(t, `(family:"is")`, Raw(`(family:"is")`).String())
+	assert.Equal(t, `*`, All().String())
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\+b\-c\:d\ e\(f\)\[g\]\{h\}\/i\\j\"k\*l\?m`, Escape(`a+b-c:d e(f)[g]{h}/i\j"k*l?m`))
+	assert.Equal(t, `plain_value.1`, Escape(`plain_value.1`))
+}
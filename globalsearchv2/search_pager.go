@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SearchPager can be used to simplify the use of the "Search" method.
+// It hands the search cursor returned by each call to the next one, re-sending the
+// remaining search options (Query, Fields, AccountID, Limit, Timeout, etc.) unchanged.
+type SearchPager struct {
+	hasNext     bool
+	options     *SearchOptions
+	client      *GlobalSearchV2
+	maxItems    int64
+	itemCount   int64
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewSearchPager returns a new SearchPager instance.
+func (globalSearch *GlobalSearchV2) NewSearchPager(options *SearchOptions) (pager *SearchPager, err error) {
+	if options.SearchCursor != nil && *options.SearchCursor != "" {
+		err = core.SDKErrorf(nil, "the 'options.SearchCursor' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy SearchOptions = *options
+	pager = &SearchPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalSearch,
+	}
+	return
+}
+
+// SetMaxItems sets the maximum total number of items to be retrieved by the pager.
+// A value of 0 (the default) means that all available items will be retrieved.
+func (pager *SearchPager) SetMaxItems(maxItems int64) *SearchPager {
+	pager.maxItems = maxItems
+	return pager
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SearchPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SearchPager) GetNextWithContext(ctx context.Context) (page []ResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.SearchCursor = pager.pageContext.next
+
+	result, _, err := pager.client.SearchWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	page = result.Items
+	pager.itemCount += int64(len(page))
+	if pager.maxItems > 0 && pager.itemCount >= pager.maxItems {
+		page = page[:int64(len(page))-(pager.itemCount-pager.maxItems)]
+		pager.itemCount = pager.maxItems
+	}
+
+	// The end of the result set is signalled by a missing cursor, an empty page,
+	// or a page containing fewer items than the requested limit.
+	pager.pageContext.next = result.SearchCursor
+	pager.hasNext = pager.pageContext.next != nil && len(result.Items) > 0
+	if result.Limit != nil && int64(len(result.Items)) < *result.Limit {
+		pager.hasNext = false
+	}
+	if pager.maxItems > 0 && pager.itemCount >= pager.maxItems {
+		pager.hasNext = false
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SearchPager) GetAllWithContext(ctx context.Context) (allItems []ResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []ResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetNext() (page []ResultItem, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetAll() (allItems []ResultItem, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *SearchPager) Items(ctx context.Context) iter.Seq2[ResultItem, error] {
+	return common.Items[ResultItem](ctx, pager)
+}
@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SearchPager`, func() {
+	var testServer *httptest.Server
+	var requestBodies []map[string]interface{}
+	var requestQueries []url.Values
+
+	newService := func() *globalsearchv2.GlobalSearchV2 {
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(globalSearchService).ToNot(BeNil())
+		return globalSearchService
+	}
+
+	newSearchOptions := func() *globalsearchv2.SearchOptions {
+		return &globalsearchv2.SearchOptions{
+			Query:     core.StringPtr("family:resource_controller"),
+			Fields:    []string{"crn", "name"},
+			AccountID: core.StringPtr("testString"),
+			Limit:     core.Int64Ptr(int64(2)),
+			Timeout:   core.Int64Ptr(int64(1000)),
+		}
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Context(`Using mock server endpoint - paginated response`, func() {
+		BeforeEach(func() {
+			requestBodies = nil
+			requestQueries = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				// Verify the contents of the request
+				Expect(req.URL.EscapedPath()).To(Equal("/v3/resources/search"))
+				Expect(req.Method).To(Equal("POST"))
+
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				requestBodies = append(requestBodies, body)
+				requestQueries = append(requestQueries, req.URL.Query())
+
+				// Set mock response
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				switch body["search_cursor"] {
+				case nil:
+					fmt.Fprintf(res, "%s", `{"search_cursor":"cursor1","limit":2,"items":[{"crn":"crn1"},{"crn":"crn2"}]}`)
+				case "cursor1":
+					fmt.Fprintf(res, "%s", `{"search_cursor":"cursor2","limit":2,"items":[{"crn":"crn3"},{"crn":"crn4"}]}`)
+				case "cursor2":
+					fmt.Fprintf(res, "%s", `{"search_cursor":"cursor3","limit":2,"items":[{"crn":"crn5"}]}`)
+				default:
+					fmt.Fprintf(res, "%s", `{"limit":2,"items":[]}`)
+				}
+			}))
+		})
+		It(`Use SearchPager.GetNext successfully`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+			Expect(pager).ToNot(BeNil())
+
+			var allResults []globalsearchv2.ResultItem
+			for pager.HasNext() {
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				Expect(nextPage).ToNot(BeNil())
+				allResults = append(allResults, nextPage...)
+			}
+			Expect(len(allResults)).To(Equal(5))
+			Expect(*allResults[4].CRN).To(Equal("crn5"))
+
+			Expect(requestBodies).To(HaveLen(3))
+			for i, body := range requestBodies {
+				Expect(body["query"]).To(Equal("family:resource_controller"))
+				Expect(body["fields"]).To(ConsistOf("crn", "name"))
+				Expect(requestQueries[i].Get("account_id")).To(Equal("testString"))
+				Expect(requestQueries[i].Get("limit")).To(Equal("2"))
+				Expect(requestQueries[i].Get("timeout")).To(Equal("1000"))
+			}
+			Expect(requestBodies[1]["search_cursor"]).To(Equal("cursor1"))
+			Expect(requestBodies[2]["search_cursor"]).To(Equal("cursor2"))
+		})
+		It(`Use SearchPager.GetAll successfully`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+			Expect(pager).ToNot(BeNil())
+
+			allResults, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(allResults).ToNot(BeNil())
+			Expect(len(allResults)).To(Equal(5))
+		})
+		It(`Use SearchPager.Items successfully`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+			Expect(pager).ToNot(BeNil())
+
+			var crns []string
+			for item, err := range pager.Items(context.Background()) {
+				Expect(err).To(BeNil())
+				crns = append(crns, *item.CRN)
+			}
+			Expect(crns).To(Equal([]string{"crn1", "crn2", "crn3", "crn4", "crn5"}))
+		})
+		It(`Use SearchPager.Items and stop early`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+
+			var crns []string
+			for item, err := range pager.Items(context.Background()) {
+				Expect(err).To(BeNil())
+				crns = append(crns, *item.CRN)
+				if len(crns) == 2 {
+					break
+				}
+			}
+			Expect(crns).To(Equal([]string{"crn1", "crn2"}))
+			Expect(requestBodies).To(HaveLen(1))
+		})
+		It(`Use SearchPager with max items`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+			pager.SetMaxItems(3)
+
+			allResults, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(len(allResults)).To(Equal(3))
+			Expect(*allResults[2].CRN).To(Equal("crn3"))
+			Expect(requestBodies).To(HaveLen(2))
+			Expect(pager.HasNext()).To(BeFalse())
+		})
+		It(`Use SearchPager with a cancelled context`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			var errs []error
+			for _, err := range pager.Items(ctx) {
+				errs = append(errs, err)
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(MatchError(context.Canceled))
+			Expect(requestBodies).To(BeEmpty())
+		})
+		It(`Invoke NewSearchPager with a search cursor`, func() {
+			searchOptionsModel := newSearchOptions()
+			searchOptionsModel.SetSearchCursor("cursor1")
+
+			pager, err := newService().NewSearchPager(searchOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(pager).To(BeNil())
+		})
+	})
+	Context(`Using mock server endpoint - empty page`, func() {
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"search_cursor":"cursor1","limit":2,"items":[]}`)
+			}))
+		})
+		It(`Stop on an empty page`, func() {
+			pager, err := newService().NewSearchPager(newSearchOptions())
+			Expect(err).To(BeNil())
+
+			allResults, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(allResults).To(BeEmpty())
+			Expect(pager.HasNext()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/common/crn"
+)
+
+// ParseResourceID returns the parsed form of the ResourceID field of the Resource.
+// It returns an error if the resource is identified by an IMS ID rather than a CRN.
+func (_model *Resource) ParseResourceID() (result crn.CRN, err error) {
+	result, err = crn.ParsePtr(_model.ResourceID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "crn-parse-error", common.GetComponentInfo())
+	}
+	return
+}
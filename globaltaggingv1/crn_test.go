@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN parsing`, func() {
+	unmarshal := func(s string) map[string]json.RawMessage {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(s), &m)).To(BeNil())
+		return m
+	}
+
+	It(`Invoke Resource.ParseResourceID() successfully`, func() {
+		var model *globaltaggingv1.Resource
+		Expect(globaltaggingv1.UnmarshalResource(unmarshal(`{"resource_id": "crn:v1:bluemix:public:kms:us-south:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:12e8c9c2-a162-4b6b-a1c8-3c4e2f5a2b1c:key:abc/def"}`), &model)).To(BeNil())
+		result, err := model.ParseResourceID()
+		Expect(err).To(BeNil())
+		Expect(result.ServiceName).To(Equal("kms"))
+		Expect(result.AccountID()).To(Equal("59bcbfa6ea2f006b4ed7094c1a08dcdd"))
+		Expect(result.String()).To(Equal("crn:v1:bluemix:public:kms:us-south:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:12e8c9c2-a162-4b6b-a1c8-3c4e2f5a2b1c:key:abc/def"))
+		Expect(result.Resource).To(Equal("abc/def"))
+
+		model.ResourceID = core.StringPtr("not-a-crn")
+		_, err = model.ParseResourceID()
+		Expect(err).ToNot(BeNil())
+		model.ResourceID = nil
+		_, err = model.ParseResourceID()
+		Expect(err).To(MatchError("CRN is not set"))
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -7553,6 +7554,13 @@ func (pager *AccessGroupsPager) GetAll() (allItems []Group, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *AccessGroupsPager) Items(ctx context.Context) iter.Seq2[Group, error] {
+	return common.Items[Group](ctx, pager)
+}
+
 //
 // AccessGroupMembersPager can be used to simplify the use of the "ListAccessGroupMembers" method.
 //
@@ -7647,6 +7655,13 @@ func (pager *AccessGroupMembersPager) GetAll() (allItems []ListGroupMembersRespo
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *AccessGroupMembersPager) Items(ctx context.Context) iter.Seq2[ListGroupMembersResponseMember, error] {
+	return common.Items[ListGroupMembersResponseMember](ctx, pager)
+}
+
 //
 // TemplatesPager can be used to simplify the use of the "ListTemplates" method.
 //
@@ -7741,6 +7756,13 @@ func (pager *TemplatesPager) GetAll() (allItems []GroupTemplate, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *TemplatesPager) Items(ctx context.Context) iter.Seq2[GroupTemplate, error] {
+	return common.Items[GroupTemplate](ctx, pager)
+}
+
 //
 // TemplateVersionsPager can be used to simplify the use of the "ListTemplateVersions" method.
 //
@@ -7834,3 +7856,10 @@ func (pager *TemplateVersionsPager) GetAll() (allItems []ListTemplateVersionResp
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *TemplateVersionsPager) Items(ctx context.Context) iter.Seq2[ListTemplateVersionResponse, error] {
+	return common.Items[ListTemplateVersionResponse](ctx, pager)
+}
@@ -0,0 +1,430 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Member types, as inferred from the prefix of an IAM ID.
+const (
+	MemberTypeUserConst    = "user"
+	MemberTypeServiceConst = "service"
+	MemberTypeProfileConst = "profile"
+)
+
+// AccessGroupSpec : The desired state of an access group.
+type AccessGroupSpec struct {
+	// The account that owns the access group.
+	AccountID string
+
+	// The name of the access group, which identifies it within the account.
+	Name string
+
+	// The description of the access group. If nil, the description is not managed.
+	Description *string
+
+	// The IAM IDs of the static members of the access group: users (`IBMid-...`),
+	// service IDs (`iam-ServiceId-...`) and trusted profiles (`iam-Profile-...`).
+	Members []string
+
+	// The dynamic rules of the access group.
+	Rules []AccessGroupRuleSpec
+}
+
+// AccessGroupRuleSpec : The desired state of a dynamic rule of an access group.
+type AccessGroupRuleSpec struct {
+	// The name of the rule, which identifies it within the access group.
+	Name string
+
+	// The number of hours that authenticated users can work in IBM Cloud before they must refresh their access.
+	Expiration int64
+
+	// The URL of the identity provider.
+	RealmName string
+
+	// The conditions of the rule.
+	Conditions []RuleConditions
+}
+
+// AccessGroupPlan : The changes required to bring an access group to the state described by an AccessGroupSpec.
+type AccessGroupPlan struct {
+	// The spec the plan was computed from.
+	Spec *AccessGroupSpec
+
+	// The ID of the access group, or an empty string if it must be created.
+	AccessGroupID string
+
+	// True if the access group must be created.
+	CreateGroup bool
+
+	// True if the description of the access group must be updated.
+	UpdateGroup bool
+
+	// The revision of the access group the plan was computed from, used as the If-Match value of the update.
+	ETag string
+
+	// The IAM IDs of the members to add.
+	AddMembers []string
+
+	// The IAM IDs of the members to remove.
+	RemoveMembers []string
+
+	// The rules to add.
+	AddRules []AccessGroupRuleSpec
+
+	// The rules to replace.
+	ReplaceRules []AccessGroupRuleReplacement
+
+	// The rules to remove.
+	RemoveRules []Rule
+}
+
+// AccessGroupRuleReplacement : A rule of an access group that must be replaced.
+type AccessGroupRuleReplacement struct {
+	// The ID of the rule.
+	RuleID string
+
+	// The revision of the rule the plan was computed from, used as the If-Match value of the replacement.
+	ETag string
+
+	// The desired state of the rule.
+	Rule AccessGroupRuleSpec
+}
+
+// IsEmpty returns true if the plan contains no changes.
+func (plan *AccessGroupPlan) IsEmpty() bool {
+	return !plan.CreateGroup && !plan.UpdateGroup &&
+		len(plan.AddMembers) == 0 && len(plan.RemoveMembers) == 0 &&
+		len(plan.AddRules) == 0 && len(plan.ReplaceRules) == 0 && len(plan.RemoveRules) == 0
+}
+
+// ReconcileAccessGroupOptions : The ReconcileAccessGroup options.
+type ReconcileAccessGroupOptions struct {
+	// If true, the plan is computed but not applied.
+	DryRun bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// ReconcileAccessGroup brings the access group described by "spec" to its desired state: it computes a plan with
+// PlanAccessGroup and, unless DryRun is set, applies it with ApplyAccessGroupPlan. The plan is returned in both cases.
+func (iamAccessGroups *IamAccessGroupsV2) ReconcileAccessGroup(ctx context.Context, spec *AccessGroupSpec, opts *ReconcileAccessGroupOptions) (plan *AccessGroupPlan, err error) {
+	if opts == nil {
+		opts = &ReconcileAccessGroupOptions{}
+	}
+	plan, err = iamAccessGroups.PlanAccessGroup(ctx, spec, opts.Headers)
+	if err != nil || opts.DryRun {
+		return
+	}
+	err = iamAccessGroups.ApplyAccessGroupPlan(ctx, plan, opts.Headers)
+	return
+}
+
+// PlanAccessGroup computes the changes required to bring the access group described by "spec" to its desired state.
+//
+// The access group is identified by its name, its members are compared with its static members, and its rules are
+// matched with the rules of the spec by name. Existing members and rules that are not in the spec are removed.
+// The revisions of the access group and of the rules to replace are recorded in the plan, so that applying it fails
+// if they are modified in the meantime.
+func (iamAccessGroups *IamAccessGroupsV2) PlanAccessGroup(ctx context.Context, spec *AccessGroupSpec, headers map[string]string) (plan *AccessGroupPlan, err error) {
+	err = spec.validate()
+	if err != nil {
+		return
+	}
+
+	plan = &AccessGroupPlan{Spec: spec}
+	group, err := iamAccessGroups.findAccessGroup(ctx, spec, headers)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		plan.CreateGroup = true
+		plan.AddMembers = slices.Clone(spec.Members)
+		plan.AddRules = slices.Clone(spec.Rules)
+		return
+	}
+	plan.AccessGroupID = *group.ID
+
+	// The list operation does not return the revision of the access group, so it is retrieved.
+	if spec.Description != nil && core.StringNilMapper(group.Description) != *spec.Description {
+		getOptions := iamAccessGroups.NewGetAccessGroupOptions(plan.AccessGroupID)
+		getOptions.Headers = headers
+		var response *core.DetailedResponse
+		_, response, err = iamAccessGroups.GetAccessGroupWithContext(ctx, getOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-access-group-error")
+			return nil, err
+		}
+		plan.UpdateGroup = true
+		plan.ETag = response.GetHeaders().Get("ETag")
+	}
+
+	members, err := iamAccessGroups.listStaticMembers(ctx, plan.AccessGroupID, headers)
+	if err != nil {
+		return nil, err
+	}
+	for _, iamID := range spec.Members {
+		if !slices.Contains(members, iamID) {
+			plan.AddMembers = append(plan.AddMembers, iamID)
+		}
+	}
+	for _, iamID := range members {
+		if !slices.Contains(spec.Members, iamID) {
+			plan.RemoveMembers = append(plan.RemoveMembers, iamID)
+		}
+	}
+
+	listRulesOptions := iamAccessGroups.NewListAccessGroupRulesOptions(plan.AccessGroupID)
+	listRulesOptions.Headers = headers
+	rules, _, err := iamAccessGroups.ListAccessGroupRulesWithContext(ctx, listRulesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-access-group-rules-error")
+		return nil, err
+	}
+	existing := map[string]Rule{}
+	for _, rule := range rules.Rules {
+		name := core.StringNilMapper(rule.Name)
+		if _, duplicate := existing[name]; duplicate || !slices.ContainsFunc(spec.Rules, func(r AccessGroupRuleSpec) bool { return r.Name == name }) {
+			plan.RemoveRules = append(plan.RemoveRules, rule)
+			continue
+		}
+		existing[name] = rule
+	}
+	for _, ruleSpec := range spec.Rules {
+		rule, found := existing[ruleSpec.Name]
+		if !found {
+			plan.AddRules = append(plan.AddRules, ruleSpec)
+			continue
+		}
+		if ruleSpec.matches(&rule) {
+			continue
+		}
+
+		getRuleOptions := iamAccessGroups.NewGetAccessGroupRuleOptions(plan.AccessGroupID, *rule.ID)
+		getRuleOptions.Headers = headers
+		var response *core.DetailedResponse
+		_, response, err = iamAccessGroups.GetAccessGroupRuleWithContext(ctx, getRuleOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-access-group-rule-error")
+			return nil, err
+		}
+		plan.ReplaceRules = append(plan.ReplaceRules, AccessGroupRuleReplacement{
+			RuleID: *rule.ID,
+			ETag:   response.GetHeaders().Get("ETag"),
+			Rule:   ruleSpec,
+		})
+	}
+	return
+}
+
+// ApplyAccessGroupPlan applies the changes of "plan", as computed by PlanAccessGroup.
+// If the access group is created, its ID is recorded in the plan.
+// The changes are applied in order and the first error stops the application, leaving the remaining changes unapplied.
+func (iamAccessGroups *IamAccessGroupsV2) ApplyAccessGroupPlan(ctx context.Context, plan *AccessGroupPlan, headers map[string]string) (err error) {
+	if plan == nil || plan.Spec == nil {
+		err = core.SDKErrorf(nil, "the plan must be computed by PlanAccessGroup", "missing-plan", common.GetComponentInfo())
+		return
+	}
+	spec := plan.Spec
+
+	if plan.CreateGroup {
+		createOptions := iamAccessGroups.NewCreateAccessGroupOptions(spec.AccountID, spec.Name)
+		createOptions.Description = spec.Description
+		createOptions.Headers = headers
+		var group *Group
+		group, _, err = iamAccessGroups.CreateAccessGroupWithContext(ctx, createOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-access-group-error")
+			return
+		}
+		plan.AccessGroupID = *group.ID
+		plan.CreateGroup = false
+	}
+
+	if plan.UpdateGroup {
+		updateOptions := iamAccessGroups.NewUpdateAccessGroupOptions(plan.AccessGroupID, plan.ETag)
+		updateOptions.Description = spec.Description
+		updateOptions.Headers = headers
+		_, _, err = iamAccessGroups.UpdateAccessGroupWithContext(ctx, updateOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "update-access-group-error")
+			return
+		}
+	}
+
+	if len(plan.AddMembers) > 0 {
+		addOptions := iamAccessGroups.NewAddMembersToAccessGroupOptions(plan.AccessGroupID)
+		for _, iamID := range plan.AddMembers {
+			addOptions.Members = append(addOptions.Members, AddGroupMembersRequestMembersItem{
+				IamID: core.StringPtr(iamID),
+				Type:  core.StringPtr(memberType(iamID)),
+			})
+		}
+		addOptions.Headers = headers
+		_, _, err = iamAccessGroups.AddMembersToAccessGroupWithContext(ctx, addOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "add-members-error")
+			return
+		}
+	}
+
+	if len(plan.RemoveMembers) > 0 {
+		removeOptions := iamAccessGroups.NewRemoveMembersFromAccessGroupOptions(plan.AccessGroupID)
+		removeOptions.Members = plan.RemoveMembers
+		removeOptions.Headers = headers
+		_, _, err = iamAccessGroups.RemoveMembersFromAccessGroupWithContext(ctx, removeOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "remove-members-error")
+			return
+		}
+	}
+
+	for _, replacement := range plan.ReplaceRules {
+		rule := replacement.Rule
+		replaceOptions := iamAccessGroups.NewReplaceAccessGroupRuleOptions(plan.AccessGroupID, replacement.RuleID, replacement.ETag,
+			rule.Expiration, rule.RealmName, rule.Conditions)
+		replaceOptions.Name = core.StringPtr(rule.Name)
+		replaceOptions.Headers = headers
+		_, _, err = iamAccessGroups.ReplaceAccessGroupRuleWithContext(ctx, replaceOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "replace-rule-error")
+			return
+		}
+	}
+
+	for _, rule := range plan.AddRules {
+		addRuleOptions := iamAccessGroups.NewAddAccessGroupRuleOptions(plan.AccessGroupID, rule.Expiration, rule.RealmName, rule.Conditions)
+		addRuleOptions.Name = core.StringPtr(rule.Name)
+		addRuleOptions.Headers = headers
+		_, _, err = iamAccessGroups.AddAccessGroupRuleWithContext(ctx, addRuleOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "add-rule-error")
+			return
+		}
+	}
+
+	for _, rule := range plan.RemoveRules {
+		removeRuleOptions := iamAccessGroups.NewRemoveAccessGroupRuleOptions(plan.AccessGroupID, *rule.ID)
+		removeRuleOptions.Headers = headers
+		_, err = iamAccessGroups.RemoveAccessGroupRuleWithContext(ctx, removeRuleOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "remove-rule-error")
+			return
+		}
+	}
+	return
+}
+
+// findAccessGroup returns the access group with the name of "spec", or nil if there is none.
+func (iamAccessGroups *IamAccessGroupsV2) findAccessGroup(ctx context.Context, spec *AccessGroupSpec, headers map[string]string) (*Group, error) {
+	listOptions := iamAccessGroups.NewListAccessGroupsOptions(spec.AccountID)
+	listOptions.Search = core.StringPtr("name:" + spec.Name)
+	listOptions.Headers = headers
+	pager, err := iamAccessGroups.NewAccessGroupsPager(listOptions)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-access-groups-error")
+	}
+	groups, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-access-groups-error")
+	}
+	for _, group := range groups {
+		if core.StringNilMapper(group.Name) == spec.Name {
+			return &group, nil
+		}
+	}
+	return nil, nil
+}
+
+// listStaticMembers returns the IAM IDs of the static members of an access group.
+func (iamAccessGroups *IamAccessGroupsV2) listStaticMembers(ctx context.Context, accessGroupID string, headers map[string]string) ([]string, error) {
+	listOptions := iamAccessGroups.NewListAccessGroupMembersOptions(accessGroupID)
+	listOptions.SetMembershipType("static")
+	listOptions.Headers = headers
+	pager, err := iamAccessGroups.NewAccessGroupMembersPager(listOptions)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-access-group-members-error")
+	}
+	members, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-access-group-members-error")
+	}
+	iamIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		iamIDs = append(iamIDs, core.StringNilMapper(member.IamID))
+	}
+	return iamIDs, nil
+}
+
+// validate returns an error if the spec is incomplete or contains duplicate members or rules.
+func (spec *AccessGroupSpec) validate() error {
+	if spec == nil || spec.AccountID == "" || spec.Name == "" {
+		return core.SDKErrorf(nil, "the spec must specify the account ID and name of the access group", "invalid-spec", common.GetComponentInfo())
+	}
+	for i, iamID := range spec.Members {
+		if iamID == "" || slices.Contains(spec.Members[:i], iamID) {
+			return core.SDKErrorf(nil, fmt.Sprintf("invalid or duplicate member '%s'", iamID), "invalid-spec", common.GetComponentInfo())
+		}
+	}
+	for i, rule := range spec.Rules {
+		if rule.Name == "" || slices.ContainsFunc(spec.Rules[:i], func(r AccessGroupRuleSpec) bool { return r.Name == rule.Name }) {
+			return core.SDKErrorf(nil, fmt.Sprintf("invalid or duplicate rule name '%s'", rule.Name), "invalid-spec", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// matches returns true if "rule" has the expiration, realm and conditions (in any order) of the spec.
+func (ruleSpec *AccessGroupRuleSpec) matches(rule *Rule) bool {
+	if rule.Expiration == nil || *rule.Expiration != ruleSpec.Expiration || core.StringNilMapper(rule.RealmName) != ruleSpec.RealmName {
+		return false
+	}
+	key := func(c RuleConditions) string {
+		return core.StringNilMapper(c.Claim) + "\x00" + core.StringNilMapper(c.Operator) + "\x00" + core.StringNilMapper(c.Value)
+	}
+	desired := make([]string, 0, len(ruleSpec.Conditions))
+	for _, c := range ruleSpec.Conditions {
+		desired = append(desired, key(c))
+	}
+	actual := make([]string, 0, len(rule.Conditions))
+	for _, c := range rule.Conditions {
+		actual = append(actual, key(c))
+	}
+	slices.Sort(desired)
+	slices.Sort(actual)
+	return slices.Equal(desired, actual)
+}
+
+// memberType returns the type of the member identified by "iamID".
+func memberType(iamID string) string {
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		return MemberTypeServiceConst
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		return MemberTypeProfileConst
+	default:
+		return MemberTypeUserConst
+	}
+}
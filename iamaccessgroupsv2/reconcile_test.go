@@ -0,0 +1,270 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ReconcileAccessGroup`, func() {
+	var testServer *httptest.Server
+	var groupExists bool
+	var groupETag string
+	var requests []string
+	var bodies map[string]map[string]interface{}
+
+	spec := &iamaccessgroupsv2.AccessGroupSpec{
+		AccountID:   "testAccount",
+		Name:        "developers",
+		Description: core.StringPtr("Developers"),
+		Members:     []string{"IBMid-keep", "IBMid-add", "iam-ServiceId-add"},
+		Rules: []iamaccessgroupsv2.AccessGroupRuleSpec{
+			{
+				Name:       "unchanged",
+				Expiration: 12,
+				RealmName:  "https://idp.example.com",
+				Conditions: []iamaccessgroupsv2.RuleConditions{
+					{Claim: core.StringPtr("groups"), Operator: core.StringPtr("EQUALS"), Value: core.StringPtr(`"dev"`)},
+					{Claim: core.StringPtr("org"), Operator: core.StringPtr("EQUALS"), Value: core.StringPtr(`"acme"`)},
+				},
+			},
+			{
+				Name:       "changed",
+				Expiration: 24,
+				RealmName:  "https://idp.example.com",
+				Conditions: []iamaccessgroupsv2.RuleConditions{
+					{Claim: core.StringPtr("groups"), Operator: core.StringPtr("CONTAINS"), Value: core.StringPtr(`"admins"`)},
+				},
+			},
+			{
+				Name:       "new",
+				Expiration: 24,
+				RealmName:  "https://idp.example.com",
+				Conditions: []iamaccessgroupsv2.RuleConditions{
+					{Claim: core.StringPtr("team"), Operator: core.StringPtr("EQUALS"), Value: core.StringPtr(`"sre"`)},
+				},
+			},
+		},
+	}
+
+	rulesJSON := `{"rules":[
+		{"id":"rule-unchanged","name":"unchanged","expiration":12,"realm_name":"https://idp.example.com","conditions":[
+			{"claim":"org","operator":"EQUALS","value":"\"acme\""},{"claim":"groups","operator":"EQUALS","value":"\"dev\""}]},
+		{"id":"rule-changed","name":"changed","expiration":12,"realm_name":"https://idp.example.com","conditions":[
+			{"claim":"groups","operator":"CONTAINS","value":"\"admins\""}]},
+		{"id":"rule-obsolete","name":"obsolete","expiration":12,"realm_name":"https://idp.example.com","conditions":[]}]}`
+
+	newService := func() *iamaccessgroupsv2.IamAccessGroupsV2 {
+		iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(iamAccessGroupsService).ToNot(BeNil())
+		return iamAccessGroupsService
+	}
+
+	BeforeEach(func() {
+		groupExists = true
+		groupETag = "group-etag"
+		requests = nil
+		bodies = map[string]map[string]interface{}{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			request := req.Method + " " + req.URL.Path
+			if ifMatch := req.Header.Get("If-Match"); ifMatch != "" {
+				request += " If-Match=" + ifMatch
+			}
+			requests = append(requests, request)
+			if body, _ := io.ReadAll(req.Body); len(body) > 0 {
+				var decoded map[string]interface{}
+				Expect(json.Unmarshal(body, &decoded)).To(BeNil())
+				bodies[req.Method+" "+req.URL.Path] = decoded
+			}
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "GET /v2/groups":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("testAccount"))
+				Expect(req.URL.Query().Get("search")).To(Equal("name:developers"))
+				groups := `{"id":"other","name":"developers-old"}`
+				if groupExists {
+					groups += `,{"id":"group-id","name":"developers","description":"Old description"}`
+				}
+				fmt.Fprintf(res, `{"limit":50,"offset":0,"total_count":2,"groups":[%s]}`, groups)
+			case "GET /v2/groups/group-id":
+				res.Header().Set("ETag", groupETag)
+				fmt.Fprint(res, `{"id":"group-id","name":"developers","description":"Old description"}`)
+			case "PATCH /v2/groups/group-id":
+				if req.Header.Get("If-Match") != groupETag {
+					res.WriteHeader(412)
+					fmt.Fprint(res, `{"errors":[{"code":"precondition_failed","message":"ETag mismatch"}]}`)
+					return
+				}
+				fmt.Fprint(res, `{"id":"group-id","name":"developers","description":"Developers"}`)
+			case "POST /v2/groups":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id":"created-id","name":"developers","description":"Developers"}`)
+			case "GET /v2/groups/group-id/members":
+				Expect(req.URL.Query().Get("membership_type")).To(Equal("static"))
+				fmt.Fprint(res, `{"limit":50,"offset":0,"total_count":2,"members":[{"iam_id":"IBMid-keep"},{"iam_id":"IBMid-remove"}]}`)
+			case "PUT /v2/groups/group-id/members", "PUT /v2/groups/created-id/members":
+				fmt.Fprint(res, `{"members":[]}`)
+			case "POST /v2/groups/group-id/members/delete":
+				res.WriteHeader(207)
+				fmt.Fprint(res, `{"access_group_id":"group-id","members":[]}`)
+			case "GET /v2/groups/group-id/rules":
+				fmt.Fprint(res, rulesJSON)
+			case "GET /v2/groups/group-id/rules/rule-changed":
+				res.Header().Set("ETag", "rule-etag")
+				fmt.Fprint(res, `{"id":"rule-changed"}`)
+			case "PUT /v2/groups/group-id/rules/rule-changed":
+				fmt.Fprint(res, `{"id":"rule-changed"}`)
+			case "POST /v2/groups/group-id/rules", "POST /v2/groups/created-id/rules":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id":"rule-new"}`)
+			case "DELETE /v2/groups/group-id/rules/rule-obsolete":
+				res.WriteHeader(204)
+			default:
+				Fail("unexpected request: " + request)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Plan the changes to an existing access group without applying them`, func() {
+		plan, err := newService().ReconcileAccessGroup(context.Background(), spec, &iamaccessgroupsv2.ReconcileAccessGroupOptions{DryRun: true})
+		Expect(err).To(BeNil())
+		Expect(plan.AccessGroupID).To(Equal("group-id"))
+		Expect(plan.CreateGroup).To(BeFalse())
+		Expect(plan.UpdateGroup).To(BeTrue())
+		Expect(plan.ETag).To(Equal("group-etag"))
+		Expect(plan.AddMembers).To(Equal([]string{"IBMid-add", "iam-ServiceId-add"}))
+		Expect(plan.RemoveMembers).To(Equal([]string{"IBMid-remove"}))
+		Expect(plan.AddRules).To(HaveLen(1))
+		Expect(plan.AddRules[0].Name).To(Equal("new"))
+		Expect(plan.ReplaceRules).To(HaveLen(1))
+		Expect(plan.ReplaceRules[0].RuleID).To(Equal("rule-changed"))
+		Expect(plan.ReplaceRules[0].ETag).To(Equal("rule-etag"))
+		Expect(plan.RemoveRules).To(HaveLen(1))
+		Expect(*plan.RemoveRules[0].ID).To(Equal("rule-obsolete"))
+		Expect(plan.IsEmpty()).To(BeFalse())
+
+		for _, request := range requests {
+			Expect(request).To(HavePrefix("GET "))
+		}
+	})
+	It(`Apply the changes to an existing access group`, func() {
+		plan, err := newService().ReconcileAccessGroup(context.Background(), spec, nil)
+		Expect(err).To(BeNil())
+		Expect(plan).ToNot(BeNil())
+		Expect(requests).To(ContainElements(
+			"PATCH /v2/groups/group-id If-Match=group-etag",
+			"PUT /v2/groups/group-id/members",
+			"POST /v2/groups/group-id/members/delete",
+			"PUT /v2/groups/group-id/rules/rule-changed If-Match=rule-etag",
+			"POST /v2/groups/group-id/rules",
+			"DELETE /v2/groups/group-id/rules/rule-obsolete",
+		))
+
+		Expect(bodies["PUT /v2/groups/group-id/members"]["members"]).To(Equal([]interface{}{
+			map[string]interface{}{"iam_id": "IBMid-add", "type": "user"},
+			map[string]interface{}{"iam_id": "iam-ServiceId-add", "type": "service"},
+		}))
+		Expect(bodies["POST /v2/groups/group-id/members/delete"]["members"]).To(Equal([]interface{}{"IBMid-remove"}))
+		Expect(bodies["PUT /v2/groups/group-id/rules/rule-changed"]["expiration"]).To(Equal(float64(24)))
+		Expect(bodies["POST /v2/groups/group-id/rules"]["name"]).To(Equal("new"))
+	})
+	It(`Create a missing access group`, func() {
+		groupExists = false
+		plan, err := newService().ReconcileAccessGroup(context.Background(), spec, nil)
+		Expect(err).To(BeNil())
+		Expect(plan.AccessGroupID).To(Equal("created-id"))
+		Expect(requests).To(Equal([]string{
+			"GET /v2/groups",
+			"POST /v2/groups",
+			"PUT /v2/groups/created-id/members",
+			"POST /v2/groups/created-id/rules",
+			"POST /v2/groups/created-id/rules",
+			"POST /v2/groups/created-id/rules",
+		}))
+		Expect(bodies["POST /v2/groups"]).To(Equal(map[string]interface{}{"name": "developers", "description": "Developers"}))
+	})
+	It(`Fail to apply a plan when the access group was modified`, func() {
+		service := newService()
+		plan, err := service.PlanAccessGroup(context.Background(), spec, nil)
+		Expect(err).To(BeNil())
+
+		groupETag = "modified-etag"
+		err = service.ApplyAccessGroupPlan(context.Background(), plan, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requests).ToNot(ContainElement(HavePrefix("PUT ")))
+	})
+	It(`Report nothing to do for an access group in its desired state`, func() {
+		desired := &iamaccessgroupsv2.AccessGroupSpec{
+			AccountID: "testAccount",
+			Name:      "developers",
+			Members:   []string{"IBMid-remove", "IBMid-keep"},
+			Rules:     spec.Rules[:1],
+		}
+		plan, err := newService().PlanAccessGroup(context.Background(), desired, nil)
+		Expect(err).To(BeNil())
+		Expect(plan.RemoveRules).To(HaveLen(2))
+
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/v2/groups":
+				fmt.Fprint(res, `{"limit":50,"offset":0,"total_count":1,"groups":[{"id":"group-id","name":"developers"}]}`)
+			case "/v2/groups/group-id/members":
+				fmt.Fprint(res, `{"limit":50,"offset":0,"total_count":2,"members":[{"iam_id":"IBMid-keep"},{"iam_id":"IBMid-remove"}]}`)
+			case "/v2/groups/group-id/rules":
+				fmt.Fprint(res, `{"rules":[{"id":"rule-unchanged","name":"unchanged","expiration":12,"realm_name":"https://idp.example.com","conditions":[
+					{"claim":"org","operator":"EQUALS","value":"\"acme\""},{"claim":"groups","operator":"EQUALS","value":"\"dev\""}]}]}`)
+			}
+		})
+		plan, err = newService().PlanAccessGroup(context.Background(), desired, nil)
+		Expect(err).To(BeNil())
+		Expect(plan.IsEmpty()).To(BeTrue())
+	})
+	It(`Reject an invalid spec`, func() {
+		_, err := newService().PlanAccessGroup(context.Background(), &iamaccessgroupsv2.AccessGroupSpec{AccountID: "testAccount"}, nil)
+		Expect(err).ToNot(BeNil())
+		_, err = newService().PlanAccessGroup(context.Background(), &iamaccessgroupsv2.AccessGroupSpec{
+			AccountID: "testAccount",
+			Name:      "developers",
+			Members:   []string{"IBMid-1", "IBMid-1"},
+		}, nil)
+		Expect(err).ToNot(BeNil())
+		err = newService().ApplyAccessGroupPlan(context.Background(), nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
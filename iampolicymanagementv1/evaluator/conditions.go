@@ -0,0 +1,363 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Logical operators of rules with nested conditions.
+const (
+	operatorAnd = "and"
+	operatorOr  = "or"
+)
+
+// condition is the common form of the rules and nested conditions of a policy.
+type condition struct {
+	key        string
+	operator   string
+	value      interface{}
+	conditions []condition
+}
+
+// ruleCondition converts the rule of a policy into a condition.
+func ruleCondition(rule iampolicymanagementv1.V2PolicyRuleIntf) (c condition, err error) {
+	switch rule := rule.(type) {
+	case *iampolicymanagementv1.V2PolicyRule:
+		c = condition{key: core.StringNilMapper(rule.Key), operator: core.StringNilMapper(rule.Operator), value: rule.Value}
+		for _, nested := range rule.Conditions {
+			var n condition
+			n, err = nestedCondition(nested)
+			if err != nil {
+				return
+			}
+			c.conditions = append(c.conditions, n)
+		}
+	case *iampolicymanagementv1.V2PolicyRuleRuleAttribute:
+		c = condition{key: core.StringNilMapper(rule.Key), operator: core.StringNilMapper(rule.Operator), value: rule.Value}
+	case *iampolicymanagementv1.V2PolicyRuleRuleWithNestedConditions:
+		c = condition{operator: core.StringNilMapper(rule.Operator)}
+		for _, nested := range rule.Conditions {
+			var n condition
+			n, err = nestedCondition(nested)
+			if err != nil {
+				return
+			}
+			c.conditions = append(c.conditions, n)
+		}
+	default:
+		err = fmt.Errorf("unsupported rule type %T", rule)
+	}
+	return
+}
+
+// nestedCondition converts a nested condition of a rule into a condition.
+func nestedCondition(nested iampolicymanagementv1.NestedConditionIntf) (c condition, err error) {
+	switch nested := nested.(type) {
+	case *iampolicymanagementv1.NestedCondition:
+		c = condition{key: core.StringNilMapper(nested.Key), operator: core.StringNilMapper(nested.Operator), value: nested.Value}
+		c.conditions = attributeConditions(nested.Conditions)
+	case *iampolicymanagementv1.NestedConditionRuleAttribute:
+		c = condition{key: core.StringNilMapper(nested.Key), operator: core.StringNilMapper(nested.Operator), value: nested.Value}
+	case *iampolicymanagementv1.NestedConditionRuleWithConditions:
+		c = condition{operator: core.StringNilMapper(nested.Operator), conditions: attributeConditions(nested.Conditions)}
+	default:
+		err = fmt.Errorf("unsupported condition type %T", nested)
+	}
+	return
+}
+
+func attributeConditions(attributes []iampolicymanagementv1.RuleAttribute) (conditions []condition) {
+	for _, attribute := range attributes {
+		conditions = append(conditions, condition{
+			key:      core.StringNilMapper(attribute.Key),
+			operator: core.StringNilMapper(attribute.Operator),
+			value:    attribute.Value,
+		})
+	}
+	return
+}
+
+// eval returns true if the condition holds for "req" at time "now".
+func (c condition) eval(req *Request, now time.Time) (bool, error) {
+	switch c.operator {
+	case operatorAnd:
+		for _, nested := range c.conditions {
+			holds, err := nested.eval(req, now)
+			if err != nil || !holds {
+				return false, err
+			}
+		}
+		return true, nil
+	case operatorOr:
+		for _, nested := range c.conditions {
+			holds, err := nested.eval(req, now)
+			if err != nil || holds {
+				return holds, err
+			}
+		}
+		return false, nil
+	}
+
+	if holds, ok, err := matchTime(c.operator, c.value, now); ok {
+		if err != nil {
+			return false, fmt.Errorf("condition '%s': %w", c.key, err)
+		}
+		return holds, nil
+	}
+
+	values, err := resolve(c.key, req)
+	if err == nil {
+		var holds bool
+		holds, err = matchString(c.operator, c.value, values)
+		if err == nil {
+			return holds, nil
+		}
+	}
+	return false, fmt.Errorf("condition '%s': %w", c.key, err)
+}
+
+// resolve returns the values of the attribute named by a condition key of the form
+// "{{<environment|resource|subject>.attributes.<name>}}".
+func resolve(key string, req *Request) ([]string, error) {
+	reference, ok := strings.CutPrefix(key, "{{")
+	if ok {
+		reference, ok = strings.CutSuffix(reference, "}}")
+	}
+	source, name, found := strings.Cut(reference, ".attributes.")
+	if !ok || !found {
+		return nil, fmt.Errorf("unsupported key '%s'", key)
+	}
+
+	var attributes map[string]string
+	switch source {
+	case "environment":
+		attributes = req.Environment
+	case "resource":
+		attributes = req.Resource
+	case "subject":
+		attributes = req.Subject
+	default:
+		return nil, fmt.Errorf("unsupported key '%s'", key)
+	}
+	if value, ok := attributes[name]; ok {
+		return []string{value}, nil
+	}
+	return nil, nil
+}
+
+// matchString returns true if any of "values" satisfies the string operator "operator"
+// with the policy value "expected".
+func matchString(operator string, expected interface{}, values []string) (bool, error) {
+	switch operator {
+	case "stringEquals":
+		s, err := toString(expected)
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(values, s), nil
+	case "stringEqualsAnyOf":
+		list, err := toStrings(expected)
+		if err != nil {
+			return false, err
+		}
+		return slices.ContainsFunc(values, func(v string) bool { return slices.Contains(list, v) }), nil
+	case "stringMatch":
+		s, err := toString(expected)
+		if err != nil {
+			return false, err
+		}
+		return slices.ContainsFunc(values, func(v string) bool { return common.WildcardMatch(s, v) }), nil
+	case "stringMatchAnyOf":
+		list, err := toStrings(expected)
+		if err != nil {
+			return false, err
+		}
+		return slices.ContainsFunc(values, func(v string) bool {
+			return slices.ContainsFunc(list, func(pattern string) bool { return common.WildcardMatch(pattern, v) })
+		}), nil
+	case "stringExists":
+		exists, err := toBool(expected)
+		if err != nil {
+			return false, err
+		}
+		return (len(values) > 0) == exists, nil
+	}
+	return false, fmt.Errorf("unsupported operator '%s'", operator)
+}
+
+// comparisons maps the suffix of a time-based operator to the test of the result of a comparison.
+var comparisons = map[string]func(int) bool{
+	"GreaterThan":         func(c int) bool { return c > 0 },
+	"GreaterThanOrEquals": func(c int) bool { return c >= 0 },
+	"LessThan":            func(c int) bool { return c < 0 },
+	"LessThanOrEquals":    func(c int) bool { return c <= 0 },
+}
+
+// matchTime evaluates the time-based operator "operator" against "now".
+// It returns ok=false if "operator" is not a time-based operator.
+//
+// The policy values use the forms accepted by IAM: "09:00:00+00:00" for times, "2025-01-31"
+// for dates, "2025-01-31T09:00:00+00:00" for date-times and "1+00:00" (Monday) to "7+00:00"
+// (Sunday) for days of the week. "now" is converted to the time zone of the value before comparison.
+func matchTime(operator string, expected interface{}, now time.Time) (holds bool, ok bool, err error) {
+	switch operator {
+	case "dayOfWeekEquals":
+		holds, err = matchDay(expected, now)
+		return holds, true, err
+	case "dayOfWeekAnyOf":
+		var days []string
+		days, err = toStrings(expected)
+		for _, day := range days {
+			if err != nil || holds {
+				break
+			}
+			holds, err = matchDay(day, now)
+		}
+		return holds, true, err
+	}
+
+	for _, prefix := range []string{"dateTime", "date", "time"} {
+		suffix, found := strings.CutPrefix(operator, prefix)
+		test, known := comparisons[suffix]
+		if !found || !known {
+			continue
+		}
+
+		var s string
+		if s, err = toString(expected); err != nil {
+			return false, true, err
+		}
+		var c int
+		switch prefix {
+		case "dateTime":
+			var t time.Time
+			if t, err = time.Parse(time.RFC3339, s); err == nil {
+				c = now.Compare(t)
+			}
+		case "date":
+			var t time.Time
+			if t, err = parseTime(s, "2006-01-02Z07:00", "2006-01-02"); err == nil {
+				local := now.In(t.Location())
+				c = cmp.Compare(local.Format(time.DateOnly), t.Format(time.DateOnly))
+			}
+		case "time":
+			var t time.Time
+			if t, err = parseTime(s, "15:04:05Z07:00", "15:04Z07:00", "15:04:05", "15:04"); err == nil {
+				local := now.In(t.Location())
+				c = cmp.Compare(local.Format(time.TimeOnly), t.Format(time.TimeOnly))
+			}
+		}
+		if err != nil {
+			return false, true, fmt.Errorf("invalid value '%s' for operator '%s'", s, operator)
+		}
+		return test(c), true, nil
+	}
+	return false, false, nil
+}
+
+// matchDay returns true if "now" falls on the day of the week "expected", in its time zone.
+func matchDay(expected interface{}, now time.Time) (bool, error) {
+	s, err := toString(expected)
+	if err != nil {
+		return false, err
+	}
+	if s == "" {
+		return false, fmt.Errorf("invalid day of the week '%s'", s)
+	}
+	day, err := strconv.Atoi(s[:1])
+	location := time.UTC
+	if err == nil && len(s) > 1 {
+		var t time.Time
+		t, err = time.Parse("Z07:00", s[1:])
+		location = t.Location()
+	}
+	if err != nil || day < 1 || day > 7 {
+		return false, fmt.Errorf("invalid day of the week '%s'", s)
+	}
+
+	// time.Weekday numbers the days from Sunday (0), IAM from Monday (1) to Sunday (7).
+	weekday := int(now.In(location).Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return weekday == day, nil
+}
+
+func parseTime(s string, layouts ...string) (t time.Time, err error) {
+	for _, layout := range layouts {
+		if t, err = time.Parse(layout, s); err == nil {
+			return
+		}
+	}
+	return
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case *string:
+		if v != nil {
+			return *v, nil
+		}
+	}
+	return "", fmt.Errorf("expected a string value, got %v", value)
+}
+
+func toStrings(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, element := range v {
+			s, err := toString(element)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("expected a list of strings, got %v", value)
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case *bool:
+		if v != nil {
+			return *v, nil
+		}
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("expected a boolean value, got %v", value)
+}
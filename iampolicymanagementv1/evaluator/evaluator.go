@@ -0,0 +1,317 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package evaluator evaluates IAM v2 access policies (iampolicymanagementv1.V2Policy) locally,
+// so that policies and policy templates can be tested without calling IAM:
+//
+//	e := evaluator.New(policies, nil)
+//	decision, err := e.Evaluate(&evaluator.Request{
+//		Subject:  map[string]string{"iam_id": "IBMid-123"},
+//		Resource: map[string]string{"accountId": accountID, "serviceName": "cloud-object-storage"},
+//		Role:     "crn:v1:bluemix:public:iam::::serviceRole:Writer",
+//		Time:     time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+//	})
+//
+// A policy allows a request when it is active, its subject and resource attributes and its
+// resource tags match the request, its rule (if any) holds at the time of the request, and it
+// grants the requested role or action. As in IAM, policies only grant access: a request is
+// denied when no policy allows it.
+package evaluator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/crn"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Names of the subject and resource attributes commonly used in policies.
+const (
+	SubjectAttributeIamID         = "iam_id"
+	SubjectAttributeAccessGroupID = "access_group_id"
+
+	ResourceAttributeAccountID       = "accountId"
+	ResourceAttributeServiceName     = "serviceName"
+	ResourceAttributeServiceInstance = "serviceInstance"
+	ResourceAttributeRegion          = "region"
+	ResourceAttributeResourceType    = "resourceType"
+	ResourceAttributeResource        = "resource"
+	ResourceAttributeResourceGroupID = "resourceGroupId"
+)
+
+// Options : The options used to create an Evaluator.
+type Options struct {
+	// The actions included in each role, keyed by role ID
+	// (e.g. "crn:v1:bluemix:public:iam::::serviceRole:Writer" -> ["cloud-object-storage.object.put"]).
+	// It is used to evaluate requests for an action, in addition to the actions of the roles of
+	// policies whose control is a ControlResponseControlWithEnrichedRoles.
+	RoleActions map[string][]string
+}
+
+// Evaluator : Evaluates requests against a set of policies.
+type Evaluator struct {
+	policies    []iampolicymanagementv1.V2Policy
+	roleActions map[string][]string
+}
+
+// Request : The access request to evaluate.
+type Request struct {
+	// The attributes of the subject (e.g. "iam_id").
+	Subject map[string]string
+
+	// The access groups the subject is a member of. A policy whose subject has an
+	// "access_group_id" attribute matches if it matches any of them.
+	AccessGroupIDs []string
+
+	// The attributes of the resource (e.g. "accountId", "serviceName", "resourceGroupId").
+	// ResourceAttributesFromCRN can be used to obtain them from the CRN of the resource.
+	Resource map[string]string
+
+	// The access tags of the resource, in the form "key:value".
+	ResourceTags []string
+
+	// The attributes of the environment, used by rule conditions with keys of the form
+	// "{{environment.attributes.<name>}}" and string operators.
+	Environment map[string]string
+
+	// The role to check (e.g. "crn:v1:bluemix:public:iam::::role:Viewer").
+	Role string
+
+	// The action to check (e.g. "cloud-object-storage.object.get").
+	Action string
+
+	// The time of the request, used by the time-based conditions of rules. Defaults to the current time.
+	Time time.Time
+}
+
+// Decision : The result of the evaluation of a request.
+type Decision struct {
+	// True if at least one policy allows the request.
+	Allowed bool
+
+	// The policies that allow the request.
+	Policies []iampolicymanagementv1.V2Policy
+}
+
+// New returns an Evaluator for the specified policies.
+func New(policies []iampolicymanagementv1.V2Policy, options *Options) *Evaluator {
+	e := &Evaluator{
+		policies:    slices.Clone(policies),
+		roleActions: map[string][]string{},
+	}
+	if options != nil {
+		for role, actions := range options.RoleActions {
+			e.roleActions[role] = slices.Clone(actions)
+		}
+	}
+	return e
+}
+
+// Evaluate returns the decision for "req".
+// At least one of Role and Action must be specified; if both are, a policy must grant both.
+// An error is returned if a policy uses an unsupported operator or a malformed value.
+func (e *Evaluator) Evaluate(req *Request) (*Decision, error) {
+	if req == nil {
+		return nil, errors.New("the request must not be nil")
+	}
+	if req.Role == "" && req.Action == "" {
+		return nil, errors.New("the request must specify a role or an action")
+	}
+
+	now := req.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	decision := &Decision{}
+	for _, policy := range e.policies {
+		allowed, err := e.allows(&policy, req, now)
+		if err != nil {
+			return nil, fmt.Errorf("policy '%s': %w", core.StringNilMapper(policy.ID), err)
+		}
+		if allowed {
+			decision.Policies = append(decision.Policies, policy)
+		}
+	}
+	decision.Allowed = len(decision.Policies) > 0
+	return decision, nil
+}
+
+// ResourceAttributesFromCRN returns the resource attributes identifying the resource named by "c".
+func ResourceAttributesFromCRN(c crn.CRN) map[string]string {
+	attributes := map[string]string{}
+	set := func(key string, value string) {
+		if value != "" {
+			attributes[key] = value
+		}
+	}
+	set(ResourceAttributeAccountID, c.AccountID())
+	set(ResourceAttributeServiceName, c.ServiceName)
+	set(ResourceAttributeServiceInstance, c.ServiceInstance)
+	if c.Location != "global" {
+		set(ResourceAttributeRegion, c.Location)
+	}
+	set(ResourceAttributeResourceType, c.ResourceType)
+	set(ResourceAttributeResource, c.Resource)
+	return attributes
+}
+
+// allows returns true if "policy" allows "req".
+func (e *Evaluator) allows(policy *iampolicymanagementv1.V2Policy, req *Request, now time.Time) (bool, error) {
+	if policy.State != nil && *policy.State != iampolicymanagementv1.V2PolicyStateActiveConst {
+		return false, nil
+	}
+
+	matched, err := matchSubject(policy.Subject, req)
+	if err != nil || !matched {
+		return false, err
+	}
+	matched, err = matchResource(policy.Resource, req)
+	if err != nil || !matched {
+		return false, err
+	}
+	if policy.Rule != nil {
+		rule, err := ruleCondition(policy.Rule)
+		if err != nil {
+			return false, err
+		}
+		matched, err = rule.eval(req, now)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return e.grants(policy.Control, req), nil
+}
+
+// matchSubject returns true if every attribute of "subject" matches the subject of "req".
+// A policy without subject attributes, such as the policy of a policy template, matches any subject.
+func matchSubject(subject *iampolicymanagementv1.V2PolicySubject, req *Request) (bool, error) {
+	if subject == nil {
+		return true, nil
+	}
+	for _, attribute := range subject.Attributes {
+		key := core.StringNilMapper(attribute.Key)
+		var values []string
+		if value, ok := req.Subject[key]; ok {
+			values = append(values, value)
+		}
+		if key == SubjectAttributeAccessGroupID {
+			values = append(values, req.AccessGroupIDs...)
+		}
+		matched, err := matchString(core.StringNilMapper(attribute.Operator), attribute.Value, values)
+		if err != nil {
+			return false, fmt.Errorf("subject attribute '%s': %w", key, err)
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchResource returns true if every attribute and tag of "resource" matches the resource of "req".
+func matchResource(resource *iampolicymanagementv1.V2PolicyResource, req *Request) (bool, error) {
+	if resource == nil {
+		return true, nil
+	}
+	for _, attribute := range resource.Attributes {
+		key := core.StringNilMapper(attribute.Key)
+		var values []string
+		if value, ok := req.Resource[key]; ok {
+			values = append(values, value)
+		}
+		matched, err := matchString(core.StringNilMapper(attribute.Operator), attribute.Value, values)
+		if err != nil {
+			return false, fmt.Errorf("resource attribute '%s': %w", key, err)
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	for _, tag := range resource.Tags {
+		key := core.StringNilMapper(tag.Key)
+		var values []string
+		for _, resourceTag := range req.ResourceTags {
+			if k, v, found := strings.Cut(resourceTag, ":"); found && k == key {
+				values = append(values, v)
+			}
+		}
+		matched, err := matchString(core.StringNilMapper(tag.Operator), core.StringNilMapper(tag.Value), values)
+		if err != nil {
+			return false, fmt.Errorf("resource tag '%s': %w", key, err)
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// grants returns true if "control" grants the role and action of "req".
+func (e *Evaluator) grants(control iampolicymanagementv1.ControlResponseIntf, req *Request) bool {
+	roleActions := map[string][]string{}
+	switch control := control.(type) {
+	case *iampolicymanagementv1.ControlResponse:
+		addRoles(roleActions, control.Grant)
+	case *iampolicymanagementv1.ControlResponseControl:
+		addRoles(roleActions, control.Grant)
+	case *iampolicymanagementv1.ControlResponseControlWithEnrichedRoles:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				roleID := core.StringNilMapper(role.RoleID)
+				roleActions[roleID] = append(roleActions[roleID], actionIDs(role.Actions)...)
+			}
+		}
+	}
+
+	if req.Role != "" {
+		if _, ok := roleActions[req.Role]; !ok {
+			return false
+		}
+	}
+	if req.Action != "" {
+		for roleID, actions := range roleActions {
+			if slices.Contains(actions, req.Action) || slices.Contains(e.roleActions[roleID], req.Action) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func addRoles(roleActions map[string][]string, grant *iampolicymanagementv1.Grant) {
+	if grant == nil {
+		return
+	}
+	for _, role := range grant.Roles {
+		if _, ok := roleActions[core.StringNilMapper(role.RoleID)]; !ok {
+			roleActions[core.StringNilMapper(role.RoleID)] = nil
+		}
+	}
+}
+
+func actionIDs(actions []iampolicymanagementv1.RoleAction) (ids []string) {
+	for _, action := range actions {
+		ids = append(ids, core.StringNilMapper(action.ID))
+	}
+	return
+}
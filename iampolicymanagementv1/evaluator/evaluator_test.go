@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluator
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/crn"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	accountID  = "59bcbfa6ea2f006b4ed7094c1a08dcdd"
+	viewerRole = "crn:v1:bluemix:public:iam::::role:Viewer"
+	writerRole = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+)
+
+// Monday, 10:00 UTC.
+var monday = time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+
+func unmarshalPolicy(t *testing.T, s string) iampolicymanagementv1.V2Policy {
+	var raw map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal([]byte(s), &raw))
+	var policy *iampolicymanagementv1.V2Policy
+	assert.Nil(t, iampolicymanagementv1.UnmarshalV2Policy(raw, &policy))
+	return *policy
+}
+
+func cosPolicy(t *testing.T) iampolicymanagementv1.V2Policy {
+	return unmarshalPolicy(t, `{
+		"id": "cos-writer",
+		"type": "access",
+		"state": "active",
+		"subject": {"attributes": [{"key": "access_group_id", "operator": "stringEquals", "value": "AccessGroupId-dev"}]},
+		"resource": {
+			"attributes": [
+				{"key": "accountId", "operator": "stringEquals", "value": "`+accountID+`"},
+				{"key": "serviceName", "operator": "stringEquals", "value": "cloud-object-storage"}
+			],
+			"tags": [{"key": "env", "operator": "stringMatch", "value": "dev*"}]
+		},
+		"pattern": "time-based-conditions:weekly:custom-hours",
+		"rule": {
+			"operator": "and",
+			"conditions": [
+				{"key": "{{environment.attributes.day_of_week}}", "operator": "dayOfWeekAnyOf", "value": ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]},
+				{"key": "{{environment.attributes.current_time}}", "operator": "timeGreaterThanOrEquals", "value": "09:00:00+00:00"},
+				{"key": "{{environment.attributes.current_time}}", "operator": "timeLessThanOrEquals", "value": "17:00:00+00:00"}
+			]
+		},
+		"control": {"grant": {"roles": [{"role_id": "`+writerRole+`"}]}}
+	}`)
+}
+
+func cosRequest() *Request {
+	return &Request{
+		Subject:        map[string]string{SubjectAttributeIamID: "IBMid-123"},
+		AccessGroupIDs: []string{"AccessGroupId-other", "AccessGroupId-dev"},
+		Resource: map[string]string{
+			ResourceAttributeAccountID:   accountID,
+			ResourceAttributeServiceName: "cloud-object-storage",
+		},
+		ResourceTags: []string{"env:development"},
+		Role:         writerRole,
+		Time:         monday,
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	policy := cosPolicy(t)
+	e := New([]iampolicymanagementv1.V2Policy{policy}, nil)
+
+	decision, err := e.Evaluate(cosRequest())
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, []iampolicymanagementv1.V2Policy{policy}, decision.Policies)
+
+	for name, update := range map[string]func(*Request){
+		"other access groups": func(r *Request) { r.AccessGroupIDs = []string{"AccessGroupId-other"} },
+		"other service":       func(r *Request) { r.Resource[ResourceAttributeServiceName] = "kms" },
+		"other tag":           func(r *Request) { r.ResourceTags = []string{"env:prod"} },
+		"no tags":             func(r *Request) { r.ResourceTags = nil },
+		"other role":          func(r *Request) { r.Role = viewerRole },
+		"before hours":        func(r *Request) { r.Time = monday.Add(-2 * time.Hour) },
+		"after hours":         func(r *Request) { r.Time = monday.Add(8 * time.Hour) },
+		"weekend":             func(r *Request) { r.Time = monday.AddDate(0, 0, -1) },
+	} {
+		req := cosRequest()
+		update(req)
+		decision, err := e.Evaluate(req)
+		assert.Nil(t, err, name)
+		assert.False(t, decision.Allowed, name)
+		assert.Empty(t, decision.Policies, name)
+	}
+}
+
+func TestEvaluateInactivePolicy(t *testing.T) {
+	policy := cosPolicy(t)
+	policy.State = core.StringPtr(iampolicymanagementv1.V2PolicyStateDeletedConst)
+
+	decision, err := New([]iampolicymanagementv1.V2Policy{policy}, nil).Evaluate(cosRequest())
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+}
+
+func TestEvaluateAction(t *testing.T) {
+	policy := iampolicymanagementv1.V2Policy{
+		Type:  core.StringPtr("access"),
+		State: core.StringPtr("active"),
+		Resource: &iampolicymanagementv1.V2PolicyResource{
+			Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEqualsAnyOf"), Value: []string{"kms", "hs-crypto"}},
+				{Key: core.StringPtr("resourceType"), Operator: core.StringPtr("stringExists"), Value: false},
+			},
+		},
+		Control: &iampolicymanagementv1.ControlResponseControlWithEnrichedRoles{
+			Grant: &iampolicymanagementv1.GrantWithEnrichedRoles{
+				Roles: []iampolicymanagementv1.EnrichedRoles{
+					{RoleID: core.StringPtr(viewerRole), Actions: []iampolicymanagementv1.RoleAction{{ID: core.StringPtr("kms.instance.read")}}},
+				},
+			},
+		},
+	}
+	e := New([]iampolicymanagementv1.V2Policy{policy}, &Options{
+		RoleActions: map[string][]string{viewerRole: {"kms.secrets.list"}},
+	})
+
+	req := &Request{
+		Subject:  map[string]string{SubjectAttributeIamID: "IBMid-123"},
+		Resource: ResourceAttributesFromCRN(crn.MustParse("crn:v1:bluemix:public:kms:us-south:a/" + accountID + ":instance-id::")),
+		Action:   "kms.instance.read",
+	}
+	assert.Equal(t, map[string]string{
+		ResourceAttributeAccountID:       accountID,
+		ResourceAttributeServiceName:     "kms",
+		ResourceAttributeServiceInstance: "instance-id",
+		ResourceAttributeRegion:          "us-south",
+	}, req.Resource)
+
+	for action, allowed := range map[string]bool{
+		"kms.instance.read": true,
+		"kms.secrets.list":  true,
+		"kms.secrets.write": false,
+	} {
+		req.Action = action
+		decision, err := e.Evaluate(req)
+		assert.Nil(t, err, action)
+		assert.Equal(t, allowed, decision.Allowed, action)
+	}
+
+	req.Action = "kms.instance.read"
+	req.Role = writerRole
+	decision, err := e.Evaluate(req)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+
+	req.Role = ""
+	req.Resource[ResourceAttributeResourceType] = "key"
+	decision, err = e.Evaluate(req)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+}
+
+func TestEvaluateRules(t *testing.T) {
+	for _, tc := range []struct {
+		rule    iampolicymanagementv1.V2PolicyRuleIntf
+		allowed bool
+	}{
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_date_time}}"), Operator: core.StringPtr("dateTimeGreaterThan"), Value: "2025-01-06T09:00:00+00:00"}, true},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_date_time}}"), Operator: core.StringPtr("dateTimeLessThan"), Value: "2025-01-06T11:00:00+02:00"}, false},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_date}}"), Operator: core.StringPtr("dateLessThanOrEquals"), Value: "2025-01-06"}, true},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_date}}"), Operator: core.StringPtr("dateGreaterThan"), Value: "2025-01-06"}, false},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.day_of_week}}"), Operator: core.StringPtr("dayOfWeekEquals"), Value: "1+00:00"}, true},
+		// 10:00 UTC on Monday is 00:00 on Tuesday in UTC+14, and 01:00 on Monday in UTC-09:00.
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.day_of_week}}"), Operator: core.StringPtr("dayOfWeekEquals"), Value: "2+14:00"}, true},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_time}}"), Operator: core.StringPtr("timeLessThan"), Value: "02:00:00-09:00"}, true},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{resource.attributes.path}}"), Operator: core.StringPtr("stringMatch"), Value: "reports/*.csv"}, true},
+		{&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.ip}}"), Operator: core.StringPtr("stringExists"), Value: true}, false},
+		{&iampolicymanagementv1.V2PolicyRuleRuleWithNestedConditions{
+			Operator: core.StringPtr("or"),
+			Conditions: []iampolicymanagementv1.NestedConditionIntf{
+				&iampolicymanagementv1.NestedConditionRuleAttribute{Key: core.StringPtr("{{environment.attributes.day_of_week}}"), Operator: core.StringPtr("dayOfWeekAnyOf"), Value: []string{"6+00:00", "7+00:00"}},
+				&iampolicymanagementv1.NestedConditionRuleWithConditions{
+					Operator: core.StringPtr("and"),
+					Conditions: []iampolicymanagementv1.RuleAttribute{
+						{Key: core.StringPtr("{{environment.attributes.current_time}}"), Operator: core.StringPtr("timeGreaterThanOrEquals"), Value: "09:00:00+00:00"},
+						{Key: core.StringPtr("{{resource.attributes.path}}"), Operator: core.StringPtr("stringMatchAnyOf"), Value: []string{"logs/*", "reports/20??/*"}},
+					},
+				},
+			},
+		}, true},
+	} {
+		policy := cosPolicy(t)
+		policy.Rule = tc.rule
+		req := cosRequest()
+		req.Resource["path"] = "reports/2025/january.csv"
+
+		decision, err := New([]iampolicymanagementv1.V2Policy{policy}, nil).Evaluate(req)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.allowed, decision.Allowed, "%+v", tc.rule)
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	_, err := New(nil, nil).Evaluate(&Request{})
+	assert.NotNil(t, err)
+	_, err = New(nil, nil).Evaluate(nil)
+	assert.NotNil(t, err)
+
+	for _, rule := range []iampolicymanagementv1.V2PolicyRuleIntf{
+		&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.current_time}}"), Operator: core.StringPtr("timeLessThan"), Value: "noon"},
+		&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.day_of_week}}"), Operator: core.StringPtr("dayOfWeekEquals"), Value: "8+00:00"},
+		&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{environment.attributes.ip}}"), Operator: core.StringPtr("ipInRange"), Value: "10.0.0.0/8"},
+		&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("ip"), Operator: core.StringPtr("stringEquals"), Value: "10.0.0.1"},
+		&iampolicymanagementv1.V2PolicyRuleRuleAttribute{Key: core.StringPtr("{{resource.attributes.path}}"), Operator: core.StringPtr("stringEqualsAnyOf"), Value: "not-a-list"},
+	} {
+		policy := cosPolicy(t)
+		policy.Rule = rule
+		_, err := New([]iampolicymanagementv1.V2Policy{policy}, nil).Evaluate(cosRequest())
+		assert.NotNil(t, err, "%+v", rule)
+	}
+}
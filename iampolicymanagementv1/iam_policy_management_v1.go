@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -14884,6 +14885,13 @@ func (pager *PoliciesPager) GetAll() (allItems []PolicyTemplateMetaData, err err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *PoliciesPager) Items(ctx context.Context) iter.Seq2[PolicyTemplateMetaData, error] {
+	return common.Items[PolicyTemplateMetaData](ctx, pager)
+}
+
 //
 // V2PoliciesPager can be used to simplify the use of the "ListV2Policies" method.
 //
@@ -14971,6 +14979,13 @@ func (pager *V2PoliciesPager) GetAll() (allItems []V2PolicyTemplateMetaData, err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *V2PoliciesPager) Items(ctx context.Context) iter.Seq2[V2PolicyTemplateMetaData, error] {
+	return common.Items[V2PolicyTemplateMetaData](ctx, pager)
+}
+
 //
 // PolicyTemplatesPager can be used to simplify the use of the "ListPolicyTemplates" method.
 //
@@ -15058,6 +15073,13 @@ func (pager *PolicyTemplatesPager) GetAll() (allItems []PolicyTemplate, err erro
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *PolicyTemplatesPager) Items(ctx context.Context) iter.Seq2[PolicyTemplate, error] {
+	return common.Items[PolicyTemplate](ctx, pager)
+}
+
 //
 // PolicyTemplateVersionsPager can be used to simplify the use of the "ListPolicyTemplateVersions" method.
 //
@@ -15145,6 +15167,13 @@ func (pager *PolicyTemplateVersionsPager) GetAll() (allItems []PolicyTemplate, e
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *PolicyTemplateVersionsPager) Items(ctx context.Context) iter.Seq2[PolicyTemplate, error] {
+	return common.Items[PolicyTemplate](ctx, pager)
+}
+
 //
 // PolicyAssignmentsPager can be used to simplify the use of the "ListPolicyAssignments" method.
 //
@@ -15232,6 +15261,13 @@ func (pager *PolicyAssignmentsPager) GetAll() (allItems []PolicyTemplateAssignme
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *PolicyAssignmentsPager) Items(ctx context.Context) iter.Seq2[PolicyTemplateAssignmentItemsIntf, error] {
+	return common.Items[PolicyTemplateAssignmentItemsIntf](ctx, pager)
+}
+
 //
 // ActionControlTemplatesPager can be used to simplify the use of the "ListActionControlTemplates" method.
 //
@@ -15319,6 +15355,13 @@ func (pager *ActionControlTemplatesPager) GetAll() (allItems []ActionControlTemp
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ActionControlTemplatesPager) Items(ctx context.Context) iter.Seq2[ActionControlTemplate, error] {
+	return common.Items[ActionControlTemplate](ctx, pager)
+}
+
 //
 // ActionControlTemplateVersionsPager can be used to simplify the use of the "ListActionControlTemplateVersions" method.
 //
@@ -15406,6 +15449,13 @@ func (pager *ActionControlTemplateVersionsPager) GetAll() (allItems []ActionCont
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ActionControlTemplateVersionsPager) Items(ctx context.Context) iter.Seq2[ActionControlTemplate, error] {
+	return common.Items[ActionControlTemplate](ctx, pager)
+}
+
 //
 // ActionControlAssignmentsPager can be used to simplify the use of the "ListActionControlAssignments" method.
 //
@@ -15493,6 +15543,13 @@ func (pager *ActionControlAssignmentsPager) GetAll() (allItems []ActionControlAs
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ActionControlAssignmentsPager) Items(ctx context.Context) iter.Seq2[ActionControlAssignment, error] {
+	return common.Items[ActionControlAssignment](ctx, pager)
+}
+
 //
 // RoleTemplatesPager can be used to simplify the use of the "ListRoleTemplates" method.
 //
@@ -15580,6 +15637,13 @@ func (pager *RoleTemplatesPager) GetAll() (allItems []RoleTemplate, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *RoleTemplatesPager) Items(ctx context.Context) iter.Seq2[RoleTemplate, error] {
+	return common.Items[RoleTemplate](ctx, pager)
+}
+
 //
 // RoleTemplateVersionsPager can be used to simplify the use of the "ListRoleTemplateVersions" method.
 //
@@ -15667,6 +15731,13 @@ func (pager *RoleTemplateVersionsPager) GetAll() (allItems []RoleTemplate, err e
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *RoleTemplateVersionsPager) Items(ctx context.Context) iter.Seq2[RoleTemplate, error] {
+	return common.Items[RoleTemplate](ctx, pager)
+}
+
 //
 // RoleAssignmentsPager can be used to simplify the use of the "ListRoleAssignments" method.
 //
@@ -15753,3 +15824,10 @@ func (pager *RoleAssignmentsPager) GetAll() (allItems []RoleAssignment, err erro
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *RoleAssignmentsPager) Items(ctx context.Context) iter.Seq2[RoleAssignment, error] {
+	return common.Items[RoleAssignment](ctx, pager)
+}
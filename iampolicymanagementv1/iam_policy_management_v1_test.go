@@ -588,6 +588,40 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use PoliciesPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPoliciesOptionsModel := &iampolicymanagementv1.ListPoliciesOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					IamID: core.StringPtr("testString"),
+					AccessGroupID: core.StringPtr("testString"),
+					Type: core.StringPtr("access"),
+					ServiceType: core.StringPtr("service"),
+					TagName: core.StringPtr("testString"),
+					TagValue: core.StringPtr("testString"),
+					Sort: core.StringPtr("id"),
+					Format: core.StringPtr("include_last_permit"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPoliciesPager(listPoliciesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.PolicyTemplateMetaData
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreatePolicy(createPolicyOptions *CreatePolicyOptions) - Operation response error`, func() {
@@ -3489,6 +3523,40 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use V2PoliciesPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listV2PoliciesOptionsModel := &iampolicymanagementv1.ListV2PoliciesOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					IamID: core.StringPtr("testString"),
+					AccessGroupID: core.StringPtr("testString"),
+					Type: core.StringPtr("access"),
+					ServiceType: core.StringPtr("service"),
+					ServiceName: core.StringPtr("testString"),
+					ServiceGroupID: core.StringPtr("testString"),
+					Sort: core.StringPtr("testString"),
+					Format: core.StringPtr("include_last_permit"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewV2PoliciesPager(listV2PoliciesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.V2PolicyTemplateMetaData
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateV2Policy(createV2PolicyOptions *CreateV2PolicyOptions) - Operation response error`, func() {
@@ -5197,6 +5265,37 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use PolicyTemplatesPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPolicyTemplatesOptionsModel := &iampolicymanagementv1.ListPolicyTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					State: core.StringPtr("active"),
+					Name: core.StringPtr("testString"),
+					PolicyServiceType: core.StringPtr("service"),
+					PolicyServiceName: core.StringPtr("testString"),
+					PolicyServiceGroupID: core.StringPtr("testString"),
+					PolicyType: core.StringPtr("access"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPolicyTemplatesPager(listPolicyTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.PolicyTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreatePolicyTemplate(createPolicyTemplateOptions *CreatePolicyTemplateOptions) - Operation response error`, func() {
@@ -6903,6 +7002,31 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use PolicyTemplateVersionsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPolicyTemplateVersionsOptionsModel := &iampolicymanagementv1.ListPolicyTemplateVersionsOptions{
+					PolicyTemplateID: core.StringPtr("testString"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPolicyTemplateVersionsPager(listPolicyTemplateVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.PolicyTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ReplacePolicyTemplate(replacePolicyTemplateOptions *ReplacePolicyTemplateOptions) - Operation response error`, func() {
@@ -8180,6 +8304,34 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use PolicyAssignmentsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPolicyAssignmentsOptionsModel := &iampolicymanagementv1.ListPolicyAssignmentsOptions{
+					Version: core.StringPtr("1.0"),
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					TemplateID: core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPolicyAssignmentsPager(listPolicyAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreatePolicyTemplateAssignment(createPolicyTemplateAssignmentOptions *CreatePolicyTemplateAssignmentOptions) - Operation response error`, func() {
@@ -9958,6 +10110,31 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ActionControlTemplatesPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listActionControlTemplatesOptionsModel := &iampolicymanagementv1.ListActionControlTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewActionControlTemplatesPager(listActionControlTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.ActionControlTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateActionControlTemplate(createActionControlTemplateOptions *CreateActionControlTemplateOptions) - Operation response error`, func() {
@@ -11174,6 +11351,31 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ActionControlTemplateVersionsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listActionControlTemplateVersionsOptionsModel := &iampolicymanagementv1.ListActionControlTemplateVersionsOptions{
+					ActionControlTemplateID: core.StringPtr("testString"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewActionControlTemplateVersionsPager(listActionControlTemplateVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.ActionControlTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ReplaceActionControlTemplate(replaceActionControlTemplateOptions *ReplaceActionControlTemplateOptions) - Operation response error`, func() {
@@ -12196,6 +12398,33 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ActionControlAssignmentsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listActionControlAssignmentsOptionsModel := &iampolicymanagementv1.ListActionControlAssignmentsOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					TemplateID: core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewActionControlAssignmentsPager(listActionControlAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.ActionControlAssignment
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateActionControlTemplateAssignment(createActionControlTemplateAssignmentOptions *CreateActionControlTemplateAssignmentOptions) - Operation response error`, func() {
@@ -13421,6 +13650,35 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use RoleTemplatesPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listRoleTemplatesOptionsModel := &iampolicymanagementv1.ListRoleTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					Name: core.StringPtr("testString"),
+					RoleName: core.StringPtr("testString"),
+					RoleServiceName: core.StringPtr("testString"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewRoleTemplatesPager(listRoleTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.RoleTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateRoleTemplate(createRoleTemplateOptions *CreateRoleTemplateOptions) - Operation response error`, func() {
@@ -14652,6 +14910,31 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use RoleTemplateVersionsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listRoleTemplateVersionsOptionsModel := &iampolicymanagementv1.ListRoleTemplateVersionsOptions{
+					RoleTemplateID: core.StringPtr("testString"),
+					State: core.StringPtr("active"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewRoleTemplateVersionsPager(listRoleTemplateVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.RoleTemplate
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ReplaceRoleTemplate(replaceRoleTemplateOptions *ReplaceRoleTemplateOptions) - Operation response error`, func() {
@@ -15679,6 +15962,33 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use RoleAssignmentsPager.Items successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listRoleAssignmentsOptionsModel := &iampolicymanagementv1.ListRoleAssignmentsOptions{
+					AccountID: core.StringPtr("testString"),
+					AcceptLanguage: core.StringPtr("default"),
+					TemplateID: core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewRoleAssignmentsPager(listRoleAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.RoleAssignment
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateRoleTemplateAssignment(createRoleTemplateAssignmentOptions *CreateRoleTemplateAssignmentOptions) - Operation response error`, func() {
@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+)
+
+// RouteEvaluation : The outcome of the evaluation of a route for an event, returned by EvaluateRoutes.
+type RouteEvaluation struct {
+	// The route.
+	Route *Route
+
+	// The first rule of the route that matches the event, or nil if no rule matches.
+	Rule *Rule
+
+	// The index of Rule in the rules of the route, or -1 if no rule matches.
+	RuleIndex int
+
+	// The action of the rule: "send" or "drop". Empty if no rule matches.
+	Action string
+
+	// The targets the event is sent to, empty unless the action is "send".
+	Targets []TargetReference
+}
+
+// EvaluateRoutes evaluates "routes", as returned by ListRoutes, for an event with the attributes of "event"
+// (e.g. routing.Event{routing.OperandLocation: "us-south"}), and returns one evaluation per route.
+// See the routing package for the evaluation rules.
+func EvaluateRoutes(routes []Route, event routing.Event) []RouteEvaluation {
+	evaluations := make([]RouteEvaluation, len(routes))
+	for i, evaluation := range routing.Evaluate(toRoutingRoutes(routes), event) {
+		route := &routes[i]
+		evaluations[i] = RouteEvaluation{Route: route, RuleIndex: evaluation.RuleIndex, Action: evaluation.Action}
+		if evaluation.Matched() {
+			evaluations[i].Rule = &route.Rules[evaluation.RuleIndex]
+			if evaluation.Action == RuleActionSendConst {
+				evaluations[i].Targets = evaluations[i].Rule.Targets
+			}
+		}
+	}
+	return evaluations
+}
+
+// FindUnreachableRules returns the rules of "routes" that can never apply to an event because an earlier rule
+// of their route matches every event they match, or because their inclusion filters contradict each other.
+func FindUnreachableRules(routes []Route) []routing.UnreachableRule {
+	return routing.FindUnreachableRules(toRoutingRoutes(routes))
+}
+
+// toRoutingRoutes converts "routes" to the types of the routing package.
+func toRoutingRoutes(routes []Route) []routing.Route {
+	result := make([]routing.Route, len(routes))
+	for i, route := range routes {
+		result[i] = routing.Route{ID: core.StringNilMapper(route.ID), Name: core.StringNilMapper(route.Name)}
+		for _, rule := range route.Rules {
+			routingRule := routing.Rule{Action: core.StringNilMapper(rule.Action)}
+			for _, target := range rule.Targets {
+				routingRule.Targets = append(routingRule.Targets, core.StringNilMapper(target.ID))
+			}
+			for _, filter := range rule.InclusionFilters {
+				routingRule.InclusionFilters = append(routingRule.InclusionFilters, routing.InclusionFilter{
+					Operand:  core.StringNilMapper(filter.Operand),
+					Operator: core.StringNilMapper(filter.Operator),
+					Values:   filter.Values,
+				})
+			}
+			result[i].Rules = append(result[i].Rules, routingRule)
+		}
+	}
+	return result
+}
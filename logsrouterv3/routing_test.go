@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The evaluation rules are tested in the routing package; these tests only cover the conversion of the models.
+var _ = Describe(`EvaluateRoutes`, func() {
+	target := logsrouterv3.TargetReference{ID: core.StringPtr("t1"), CRN: core.StringPtr("crn:v1:bluemix:public:logs:us-south:a/abc::target:t1"), Name: core.StringPtr("t1"), TargetType: core.StringPtr("cloud_logs")}
+	routes := []logsrouterv3.Route{{
+		ID:   core.StringPtr("r1"),
+		Name: core.StringPtr("Regional route"),
+		Rules: []logsrouterv3.Rule{
+			{Action: core.StringPtr(logsrouterv3.RuleActionDropConst), Targets: []logsrouterv3.TargetReference{}, InclusionFilters: []logsrouterv3.InclusionFilter{
+				{Operand: core.StringPtr(logsrouterv3.InclusionFilterOperandLocationConst), Operator: core.StringPtr(logsrouterv3.InclusionFilterOperatorIsConst), Values: []string{"eu-de"}},
+			}},
+			{Targets: []logsrouterv3.TargetReference{target}},
+		},
+	}}
+
+	It(`Maps the evaluations back to the routes, rules and targets`, func() {
+		evaluations := logsrouterv3.EvaluateRoutes(routes, routing.Event{routing.OperandLocation: "us-south"})
+		Expect(evaluations).To(Equal([]logsrouterv3.RouteEvaluation{{
+			Route:     &routes[0],
+			Rule:      &routes[0].Rules[1],
+			RuleIndex: 1,
+			Action:    logsrouterv3.RuleActionSendConst,
+			Targets:   []logsrouterv3.TargetReference{target},
+		}}))
+		Expect(logsrouterv3.FindUnreachableRules(routes)).To(BeEmpty())
+	})
+})
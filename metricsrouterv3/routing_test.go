@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricsrouterv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/routing"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The evaluation rules are tested in the routing package; these tests only cover the conversion of the models.
+var _ = Describe(`EvaluateRoutes`, func() {
+	target := metricsrouterv3.TargetReference{ID: core.StringPtr("t1"), CRN: core.StringPtr("crn:v1:bluemix:public:metrics-router:us-south:a/abc::target:t1"), Name: core.StringPtr("t1"), TargetType: core.StringPtr("sysdig_monitor")}
+	routes := []metricsrouterv3.Route{{
+		ID:   core.StringPtr("r1"),
+		Name: core.StringPtr("Regional route"),
+		Rules: []metricsrouterv3.Rule{
+			{Action: core.StringPtr(metricsrouterv3.RuleActionDropConst), Targets: []metricsrouterv3.TargetReference{}, InclusionFilters: []metricsrouterv3.InclusionFilter{
+				{Operand: core.StringPtr(metricsrouterv3.InclusionFilterOperandLocationConst), Operator: core.StringPtr(metricsrouterv3.InclusionFilterOperatorIsConst), Values: []string{"eu-de"}},
+			}},
+			{Targets: []metricsrouterv3.TargetReference{target}},
+		},
+	}}
+
+	It(`Maps the evaluations back to the routes, rules and targets`, func() {
+		evaluations := metricsrouterv3.EvaluateRoutes(routes, routing.Event{routing.OperandLocation: "us-south"})
+		Expect(evaluations).To(Equal([]metricsrouterv3.RouteEvaluation{{
+			Route:     &routes[0],
+			Rule:      &routes[0].Rules[1],
+			RuleIndex: 1,
+			Action:    metricsrouterv3.RuleActionSendConst,
+			Targets:   []metricsrouterv3.TargetReference{target},
+		}}))
+		Expect(metricsrouterv3.FindUnreachableRules(routes)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package broker implements the server side of the Open Service Broker API, using the models of the
+// openservicebrokerv1 package, so that a broker can be written by implementing the Broker interface:
+//
+//	handler := broker.NewHandler(myBroker, &broker.Options{Username: "user", Password: "secret"})
+//	http.ListenAndServe(":8080", handler)
+//
+// The handler decodes each request into the options struct used by the matching openservicebrokerv1
+// client method (e.g. a provision request into a ReplaceServiceInstanceOptions), calls the broker and
+// encodes its result with the status code required by the specification. Errors returned by the broker
+// are reported with the status code and error code of an *Error, or with a 500 status otherwise.
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+)
+
+// States of the last operation of a service instance.
+const (
+	LastOperationStateInProgress = openservicebrokerv1.LastOperationStateInProgressConst
+	LastOperationStateSucceeded  = openservicebrokerv1.LastOperationStateSucceededConst
+	LastOperationStateFailed     = openservicebrokerv1.LastOperationStateFailedConst
+)
+
+// Broker : The operations of a service broker.
+//
+// The InstanceID (and BindingID) fields of the options are set from the request path, and the
+// AcceptsIncomplete field from the "accepts_incomplete" query parameter. Provision, Update and
+// Deprovision must return ErrAsyncRequired, before starting the operation, if it can only be
+// performed asynchronously and AcceptsIncomplete is not true.
+type Broker interface {
+	// Catalog returns the services and plans offered by the broker.
+	Catalog(ctx context.Context) (*openservicebrokerv1.Resp1874650Root, error)
+
+	// Provision creates a service instance. It returns ErrInstanceAlreadyExists if an instance with the same ID but
+	// different attributes exists, and a result with AlreadyExists set if an identical instance exists.
+	Provision(ctx context.Context, options *openservicebrokerv1.ReplaceServiceInstanceOptions) (*ProvisionResult, error)
+
+	// Update updates a service instance.
+	Update(ctx context.Context, options *openservicebrokerv1.UpdateServiceInstanceOptions) (*OperationResult, error)
+
+	// Deprovision deletes a service instance. It returns ErrInstanceGone if the instance does not exist.
+	Deprovision(ctx context.Context, options *openservicebrokerv1.DeleteServiceInstanceOptions) (*OperationResult, error)
+
+	// LastOperation returns the state of the last asynchronous operation on a service instance.
+	// It returns ErrInstanceGone if the operation was a deprovision that completed.
+	LastOperation(ctx context.Context, options *openservicebrokerv1.GetLastOperationOptions) (*openservicebrokerv1.Resp2079894Root, error)
+
+	// Bind creates a service binding. It returns ErrBindingAlreadyExists if a binding with the same ID but
+	// different attributes exists, and a result with AlreadyExists set if an identical binding exists.
+	Bind(ctx context.Context, options *openservicebrokerv1.ReplaceServiceBindingOptions) (*BindResult, error)
+
+	// Unbind deletes a service binding. It returns ErrBindingGone if the binding does not exist.
+	Unbind(ctx context.Context, options *openservicebrokerv1.DeleteServiceBindingOptions) error
+}
+
+// InstanceStateBroker : The IBM Cloud extension of a broker that manages the state of its service instances.
+// If the broker passed to NewHandler implements it, the "/bluemix_v1/service_instances/{instance_id}" endpoints are served.
+type InstanceStateBroker interface {
+	// GetInstanceState returns the state of a service instance. It returns ErrInstanceNotFound if the instance does not exist.
+	GetInstanceState(ctx context.Context, options *openservicebrokerv1.GetServiceInstanceStateOptions) (*openservicebrokerv1.Resp1874644Root, error)
+
+	// UpdateInstanceState enables or disables a service instance. It returns ErrInstanceNotFound if the instance does not exist.
+	UpdateInstanceState(ctx context.Context, options *openservicebrokerv1.ReplaceServiceInstanceStateOptions) (*openservicebrokerv1.Resp2448145Root, error)
+}
+
+// ProvisionResult : The result of a provision request.
+type ProvisionResult struct {
+	openservicebrokerv1.Resp2079872Root
+
+	// True if the instance is being provisioned asynchronously; the response status is then 202.
+	// It must only be set if the request accepts incomplete operations; otherwise the handler
+	// reports ErrAsyncStarted.
+	Async bool `json:"-"`
+
+	// True if an identical instance already exists; the response status is then 200.
+	AlreadyExists bool `json:"-"`
+}
+
+// OperationResult : The result of an update or deprovision request.
+type OperationResult struct {
+	openservicebrokerv1.Resp2079874Root
+
+	// True if the operation is performed asynchronously; the response status is then 202.
+	// It must only be set if the request accepts incomplete operations; otherwise the handler
+	// reports ErrAsyncStarted.
+	Async bool `json:"-"`
+}
+
+// BindResult : The result of a bind request.
+type BindResult struct {
+	openservicebrokerv1.Resp2079876Root
+
+	// True if an identical binding already exists; the response status is then 200.
+	AlreadyExists bool `json:"-"`
+}
+
+// Error : An error reported to the platform, with the status code and body defined by the specification.
+type Error struct {
+	// The status code of the response.
+	StatusCode int `json:"-"`
+
+	// A machine-readable error code (e.g. "AsyncRequired"), if any.
+	ErrorCode string `json:"error,omitempty"`
+
+	// A description of the error, for the user of the platform.
+	Description string `json:"description,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if e.ErrorCode != "" {
+		return fmt.Sprintf("%d %s: %s", e.StatusCode, e.ErrorCode, e.Description)
+	}
+	return fmt.Sprintf("%d: %s", e.StatusCode, e.Description)
+}
+
+// Is returns true if "target" is an *Error with the same status code and error code,
+// so that errors.Is can be used to compare an error with ErrAsyncRequired, for example.
+func (e *Error) Is(target error) bool {
+	var t *Error
+	return errors.As(target, &t) && t.StatusCode == e.StatusCode && t.ErrorCode == e.ErrorCode
+}
+
+// NewError returns an Error with the specified status code, error code and description.
+func NewError(statusCode int, errorCode string, format string, args ...interface{}) *Error {
+	return &Error{StatusCode: statusCode, ErrorCode: errorCode, Description: fmt.Sprintf(format, args...)}
+}
+
+// Errors that a broker returns to report the conditions defined by the specification.
+// A broker can return a copy with a more specific description, e.g. NewError(http.StatusConflict, "", "...").
+var (
+	ErrBadRequest            = &Error{StatusCode: http.StatusBadRequest, Description: "the request is malformed or missing mandatory data"}
+	ErrInstanceAlreadyExists = &Error{StatusCode: http.StatusConflict, Description: "a service instance with the same ID already exists"}
+	ErrBindingAlreadyExists  = &Error{StatusCode: http.StatusConflict, Description: "a service binding with the same ID already exists"}
+	ErrInstanceNotFound      = &Error{StatusCode: http.StatusNotFound, Description: "the service instance does not exist"}
+	ErrInstanceGone          = &Error{StatusCode: http.StatusGone, Description: "the service instance does not exist"}
+	ErrBindingGone           = &Error{StatusCode: http.StatusGone, Description: "the service binding does not exist"}
+	ErrAsyncRequired         = &Error{StatusCode: http.StatusUnprocessableEntity, ErrorCode: "AsyncRequired", Description: "this service plan requires client support for asynchronous service operations"}
+	ErrConcurrencyError      = &Error{StatusCode: http.StatusUnprocessableEntity, ErrorCode: "ConcurrencyError", Description: "another operation for this service instance is in progress"}
+)
+
+// ErrAsyncStarted is reported by the handler if a broker returns an asynchronous result for a request
+// that does not accept incomplete operations, instead of refusing it with ErrAsyncRequired.
+var ErrAsyncStarted = &Error{StatusCode: http.StatusInternalServerError, ErrorCode: "AsyncStarted", Description: "the broker started an asynchronous operation although the request does not accept incomplete operations"}
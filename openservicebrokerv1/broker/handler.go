@@ -0,0 +1,367 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+)
+
+// Options : The options used to create a Handler.
+type Options struct {
+	// The credentials required to call the broker with basic authentication.
+	// If Username is empty, requests are not authenticated.
+	Username string
+	Password string
+}
+
+// Handler : An http.Handler that serves the Open Service Broker API endpoints of a Broker.
+type Handler struct {
+	broker  Broker
+	options Options
+	mux     *http.ServeMux
+}
+
+// NewHandler returns a Handler that serves the endpoints of "broker".
+func NewHandler(broker Broker, options *Options) *Handler {
+	h := &Handler{
+		broker: broker,
+		mux:    http.NewServeMux(),
+	}
+	if options != nil {
+		h.options = *options
+	}
+
+	h.mux.HandleFunc("GET /v2/catalog", h.catalog)
+	h.mux.HandleFunc("PUT /v2/service_instances/{instance_id}", h.provision)
+	h.mux.HandleFunc("PATCH /v2/service_instances/{instance_id}", h.update)
+	h.mux.HandleFunc("DELETE /v2/service_instances/{instance_id}", h.deprovision)
+	h.mux.HandleFunc("GET /v2/service_instances/{instance_id}/last_operation", h.lastOperation)
+	h.mux.HandleFunc("PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}", h.bind)
+	h.mux.HandleFunc("DELETE /v2/service_instances/{instance_id}/service_bindings/{binding_id}", h.unbind)
+	if stateBroker, ok := broker.(InstanceStateBroker); ok {
+		h.mux.HandleFunc("GET /bluemix_v1/service_instances/{instance_id}", func(res http.ResponseWriter, req *http.Request) {
+			h.getInstanceState(stateBroker, res, req)
+		})
+		h.mux.HandleFunc("PUT /bluemix_v1/service_instances/{instance_id}", func(res http.ResponseWriter, req *http.Request) {
+			h.updateInstanceState(stateBroker, res, req)
+		})
+	}
+	return h
+}
+
+// ServeHTTP authenticates the request and dispatches it to the handler of its endpoint.
+func (h *Handler) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if h.options.Username != "" {
+		username, password, ok := req.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(username), []byte(h.options.Username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(h.options.Password)) != 1 {
+			res.Header().Set("WWW-Authenticate", `Basic realm="service broker"`)
+			writeError(res, NewError(http.StatusUnauthorized, "", "invalid credentials"))
+			return
+		}
+	}
+	h.mux.ServeHTTP(res, req)
+}
+
+func (h *Handler) catalog(res http.ResponseWriter, req *http.Request) {
+	result, err := h.broker.Catalog(req.Context())
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (h *Handler) provision(res http.ResponseWriter, req *http.Request) {
+	options := &openservicebrokerv1.ReplaceServiceInstanceOptions{}
+	err := decodeRequest(req, options, &options.Headers)
+	if err == nil {
+		options.InstanceID = core.StringPtr(req.PathValue("instance_id"))
+		options.AcceptsIncomplete, err = acceptsIncomplete(req)
+	}
+	if err == nil {
+		err = requireFields(options.ServiceID, options.PlanID)
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+
+	result, err := h.broker.Provision(req.Context(), options)
+	if err == nil && result == nil {
+		result = &ProvisionResult{}
+	}
+	if err == nil && result.Async && !isTrue(options.AcceptsIncomplete) {
+		// The broker must refuse with ErrAsyncRequired before starting the operation.
+		err = ErrAsyncStarted
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	status := http.StatusCreated
+	if result.Async {
+		status = http.StatusAccepted
+	} else if result.AlreadyExists {
+		status = http.StatusOK
+	}
+	writeJSON(res, status, result)
+}
+
+func (h *Handler) update(res http.ResponseWriter, req *http.Request) {
+	options := &openservicebrokerv1.UpdateServiceInstanceOptions{}
+	err := decodeRequest(req, options, &options.Headers)
+	if err == nil {
+		options.InstanceID = core.StringPtr(req.PathValue("instance_id"))
+		options.AcceptsIncomplete, err = acceptsIncomplete(req)
+	}
+	if err == nil {
+		err = requireFields(options.ServiceID)
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+
+	result, err := h.broker.Update(req.Context(), options)
+	writeOperationResult(res, result, err, options.AcceptsIncomplete)
+}
+
+func (h *Handler) deprovision(res http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	options := &openservicebrokerv1.DeleteServiceInstanceOptions{
+		InstanceID: core.StringPtr(req.PathValue("instance_id")),
+		ServiceID:  queryValue(query.Get("service_id")),
+		PlanID:     queryValue(query.Get("plan_id")),
+		Headers:    headers(req),
+	}
+	var err error
+	options.AcceptsIncomplete, err = acceptsIncomplete(req)
+	if err == nil {
+		err = requireFields(options.ServiceID, options.PlanID)
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+
+	result, err := h.broker.Deprovision(req.Context(), options)
+	writeOperationResult(res, result, err, options.AcceptsIncomplete)
+}
+
+func (h *Handler) lastOperation(res http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	options := &openservicebrokerv1.GetLastOperationOptions{
+		InstanceID: core.StringPtr(req.PathValue("instance_id")),
+		Operation:  queryValue(query.Get("operation")),
+		ServiceID:  queryValue(query.Get("service_id")),
+		PlanID:     queryValue(query.Get("plan_id")),
+		Headers:    headers(req),
+	}
+
+	result, err := h.broker.LastOperation(req.Context(), options)
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (h *Handler) bind(res http.ResponseWriter, req *http.Request) {
+	options := &openservicebrokerv1.ReplaceServiceBindingOptions{}
+	err := decodeRequest(req, options, &options.Headers)
+	if err == nil {
+		options.InstanceID = core.StringPtr(req.PathValue("instance_id"))
+		options.BindingID = core.StringPtr(req.PathValue("binding_id"))
+		err = requireFields(options.ServiceID, options.PlanID)
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+
+	result, err := h.broker.Bind(req.Context(), options)
+	if err == nil && result == nil {
+		result = &BindResult{}
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	status := http.StatusCreated
+	if result.AlreadyExists {
+		status = http.StatusOK
+	}
+	writeJSON(res, status, result)
+}
+
+func (h *Handler) unbind(res http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	options := &openservicebrokerv1.DeleteServiceBindingOptions{
+		InstanceID: core.StringPtr(req.PathValue("instance_id")),
+		BindingID:  core.StringPtr(req.PathValue("binding_id")),
+		ServiceID:  queryValue(query.Get("service_id")),
+		PlanID:     queryValue(query.Get("plan_id")),
+		Headers:    headers(req),
+	}
+	err := requireFields(options.ServiceID, options.PlanID)
+	if err == nil {
+		err = h.broker.Unbind(req.Context(), options)
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, struct{}{})
+}
+
+func (h *Handler) getInstanceState(broker InstanceStateBroker, res http.ResponseWriter, req *http.Request) {
+	options := &openservicebrokerv1.GetServiceInstanceStateOptions{
+		InstanceID: core.StringPtr(req.PathValue("instance_id")),
+		Headers:    headers(req),
+	}
+	result, err := broker.GetInstanceState(req.Context(), options)
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (h *Handler) updateInstanceState(broker InstanceStateBroker, res http.ResponseWriter, req *http.Request) {
+	options := &openservicebrokerv1.ReplaceServiceInstanceStateOptions{}
+	err := decodeRequest(req, options, &options.Headers)
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	options.InstanceID = core.StringPtr(req.PathValue("instance_id"))
+
+	result, err := broker.UpdateInstanceState(req.Context(), options)
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+// writeOperationResult writes the response to an update or deprovision request.
+func writeOperationResult(res http.ResponseWriter, result *OperationResult, err error, acceptsIncomplete *bool) {
+	if err == nil && result == nil {
+		result = &OperationResult{}
+	}
+	if err == nil && result.Async && !isTrue(acceptsIncomplete) {
+		err = ErrAsyncStarted
+	}
+	if err != nil {
+		writeError(res, err)
+		return
+	}
+	status := http.StatusOK
+	if result.Async {
+		status = http.StatusAccepted
+	}
+	writeJSON(res, status, result)
+}
+
+// decodeRequest decodes the JSON body of "req" into "options", and sets "optionsHeaders" to the request headers.
+func decodeRequest(req *http.Request, options interface{}, optionsHeaders *map[string]string) error {
+	body, err := io.ReadAll(req.Body)
+	if err != nil || len(body) == 0 {
+		return NewError(http.StatusBadRequest, "", "the request body must be a JSON object")
+	}
+	if err := json.Unmarshal(body, options); err != nil {
+		return NewError(http.StatusBadRequest, "", "invalid request body: %s", err.Error())
+	}
+	*optionsHeaders = headers(req)
+	return nil
+}
+
+// headers returns the first value of each header of "req".
+func headers(req *http.Request) map[string]string {
+	result := make(map[string]string, len(req.Header))
+	for name := range req.Header {
+		result[name] = req.Header.Get(name)
+	}
+	return result
+}
+
+// acceptsIncomplete returns the value of the "accepts_incomplete" query parameter of "req", if set.
+func acceptsIncomplete(req *http.Request) (*bool, error) {
+	s := req.URL.Query().Get("accepts_incomplete")
+	if s == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil, NewError(http.StatusBadRequest, "", "invalid accepts_incomplete value '%s'", s)
+	}
+	return &b, nil
+}
+
+// requireFields returns a 400 error if the service ID or, when specified, the plan ID of a request is not set.
+func requireFields(serviceID *string, planID ...*string) error {
+	if serviceID == nil || *serviceID == "" {
+		return NewError(http.StatusBadRequest, "", "the service_id field is required")
+	}
+	for _, id := range planID {
+		if id == nil || *id == "" {
+			return NewError(http.StatusBadRequest, "", "the plan_id field is required")
+		}
+	}
+	return nil
+}
+
+func queryValue(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// writeError writes the response for "err": the status and body of an *Error, or a 500 status.
+// As required by the specification, the body of a 410 response is an empty object.
+func writeError(res http.ResponseWriter, err error) {
+	var brokerError *Error
+	if !errors.As(err, &brokerError) {
+		brokerError = NewError(http.StatusInternalServerError, "", "%s", err.Error())
+	}
+	if brokerError.StatusCode == http.StatusGone {
+		writeJSON(res, http.StatusGone, struct{}{})
+		return
+	}
+	writeJSON(res, brokerError.StatusCode, brokerError)
+}
+
+func writeJSON(res http.ResponseWriter, statusCode int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
@@ -0,0 +1,369 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package broker_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1/broker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testBroker is an in-memory broker whose plan "async-plan" provisions instances asynchronously.
+type testBroker struct {
+	instances map[string]*openservicebrokerv1.ReplaceServiceInstanceOptions
+	bindings  map[string]*openservicebrokerv1.ReplaceServiceBindingOptions
+	enabled   map[string]bool
+	pending   map[string]string
+}
+
+func newTestBroker() *testBroker {
+	return &testBroker{
+		instances: map[string]*openservicebrokerv1.ReplaceServiceInstanceOptions{},
+		bindings:  map[string]*openservicebrokerv1.ReplaceServiceBindingOptions{},
+		enabled:   map[string]bool{},
+		pending:   map[string]string{},
+	}
+}
+
+func (b *testBroker) Catalog(ctx context.Context) (*openservicebrokerv1.Resp1874650Root, error) {
+	return &openservicebrokerv1.Resp1874650Root{
+		Services: []openservicebrokerv1.Services{
+			{
+				ID:          core.StringPtr("service-id"),
+				Name:        core.StringPtr("test-service"),
+				Description: core.StringPtr("A test service"),
+				Bindable:    core.BoolPtr(true),
+				Plans: []openservicebrokerv1.Plans{
+					{ID: core.StringPtr("plan-id"), Name: core.StringPtr("standard"), Description: core.StringPtr("Standard plan")},
+					{ID: core.StringPtr("async-plan"), Name: core.StringPtr("async"), Description: core.StringPtr("Asynchronous plan")},
+				},
+			},
+		},
+	}, nil
+}
+
+func (b *testBroker) Provision(ctx context.Context, options *openservicebrokerv1.ReplaceServiceInstanceOptions) (*broker.ProvisionResult, error) {
+	id := *options.InstanceID
+	if existing, ok := b.instances[id]; ok {
+		if *existing.PlanID != *options.PlanID {
+			return nil, broker.ErrInstanceAlreadyExists
+		}
+		return &broker.ProvisionResult{AlreadyExists: true}, nil
+	}
+	if *options.PlanID == "async-plan" && (options.AcceptsIncomplete == nil || !*options.AcceptsIncomplete) {
+		return nil, broker.ErrAsyncRequired
+	}
+
+	b.instances[id] = options
+	b.enabled[id] = true
+	result := &broker.ProvisionResult{}
+	result.DashboardURL = core.StringPtr("https://dashboard.example.com/" + id)
+	if *options.PlanID == "async-plan" {
+		b.pending[id] = "provision"
+		result.Async = true
+		result.Operation = core.StringPtr("provision-" + id)
+	}
+	return result, nil
+}
+
+func (b *testBroker) Update(ctx context.Context, options *openservicebrokerv1.UpdateServiceInstanceOptions) (*broker.OperationResult, error) {
+	instance, ok := b.instances[*options.InstanceID]
+	if !ok {
+		return nil, broker.NewError(http.StatusBadRequest, "", "instance '%s' not found", *options.InstanceID)
+	}
+	if b.pending[*options.InstanceID] != "" {
+		return nil, broker.ErrConcurrencyError
+	}
+	if options.PlanID != nil {
+		instance.PlanID = options.PlanID
+	}
+	return &broker.OperationResult{}, nil
+}
+
+func (b *testBroker) Deprovision(ctx context.Context, options *openservicebrokerv1.DeleteServiceInstanceOptions) (*broker.OperationResult, error) {
+	if _, ok := b.instances[*options.InstanceID]; !ok {
+		return nil, broker.ErrInstanceGone
+	}
+	delete(b.instances, *options.InstanceID)
+	return &broker.OperationResult{}, nil
+}
+
+func (b *testBroker) LastOperation(ctx context.Context, options *openservicebrokerv1.GetLastOperationOptions) (*openservicebrokerv1.Resp2079894Root, error) {
+	if _, ok := b.instances[*options.InstanceID]; !ok {
+		return nil, broker.ErrInstanceGone
+	}
+	state := broker.LastOperationStateSucceeded
+	if b.pending[*options.InstanceID] != "" {
+		state = broker.LastOperationStateInProgress
+		delete(b.pending, *options.InstanceID)
+	}
+	return &openservicebrokerv1.Resp2079894Root{State: core.StringPtr(state), Description: options.Operation}, nil
+}
+
+func (b *testBroker) Bind(ctx context.Context, options *openservicebrokerv1.ReplaceServiceBindingOptions) (*broker.BindResult, error) {
+	if _, ok := b.instances[*options.InstanceID]; !ok {
+		return nil, broker.NewError(http.StatusBadRequest, "", "instance '%s' not found", *options.InstanceID)
+	}
+	if existing, ok := b.bindings[*options.BindingID]; ok {
+		if !reflect.DeepEqual(existing.Parameters, options.Parameters) {
+			return nil, broker.ErrBindingAlreadyExists
+		}
+		return &broker.BindResult{AlreadyExists: true}, nil
+	}
+	b.bindings[*options.BindingID] = options
+	result := &broker.BindResult{}
+	result.Credentials = map[string]interface{}{"apikey": "secret", "target": *options.BindResource.TargetCRN}
+	return result, nil
+}
+
+func (b *testBroker) Unbind(ctx context.Context, options *openservicebrokerv1.DeleteServiceBindingOptions) error {
+	if _, ok := b.bindings[*options.BindingID]; !ok {
+		return broker.ErrBindingGone
+	}
+	delete(b.bindings, *options.BindingID)
+	return nil
+}
+
+func (b *testBroker) GetInstanceState(ctx context.Context, options *openservicebrokerv1.GetServiceInstanceStateOptions) (*openservicebrokerv1.Resp1874644Root, error) {
+	enabled, ok := b.enabled[*options.InstanceID]
+	if !ok {
+		return nil, broker.ErrInstanceNotFound
+	}
+	return &openservicebrokerv1.Resp1874644Root{Enabled: core.BoolPtr(enabled), Active: core.BoolPtr(enabled)}, nil
+}
+
+func (b *testBroker) UpdateInstanceState(ctx context.Context, options *openservicebrokerv1.ReplaceServiceInstanceStateOptions) (*openservicebrokerv1.Resp2448145Root, error) {
+	if _, ok := b.enabled[*options.InstanceID]; !ok {
+		return nil, broker.ErrInstanceNotFound
+	}
+	b.enabled[*options.InstanceID] = *options.Enabled
+	return &openservicebrokerv1.Resp2448145Root{Enabled: options.Enabled, Active: options.Enabled}, nil
+}
+
+// eagerBroker is a broker that deprovisions instances asynchronously without checking whether
+// the request accepts incomplete operations.
+type eagerBroker struct {
+	*testBroker
+}
+
+func (b eagerBroker) Deprovision(ctx context.Context, options *openservicebrokerv1.DeleteServiceInstanceOptions) (*broker.OperationResult, error) {
+	return &broker.OperationResult{Async: true}, nil
+}
+
+var _ = Describe(`Handler`, func() {
+	var testServer *httptest.Server
+	var testBroker *testBroker
+	var service *openservicebrokerv1.OpenServiceBrokerV1
+
+	newService := func(username string, password string) *openservicebrokerv1.OpenServiceBrokerV1 {
+		openServiceBrokerService, err := openservicebrokerv1.NewOpenServiceBrokerV1(&openservicebrokerv1.OpenServiceBrokerV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BasicAuthenticator{Username: username, Password: password},
+		})
+		Expect(err).To(BeNil())
+		return openServiceBrokerService
+	}
+
+	provision := func(instanceID string, planID string, acceptsIncomplete bool) (*openservicebrokerv1.Resp2079872Root, *core.DetailedResponse, error) {
+		options := service.NewReplaceServiceInstanceOptions(instanceID)
+		options.SetServiceID("service-id")
+		options.SetPlanID(planID)
+		options.SetContext(&openservicebrokerv1.Context{AccountID: core.StringPtr("account-id"), Platform: core.StringPtr("ibmcloud")})
+		options.SetParameters(map[string]string{"size": "small"})
+		options.SetAcceptsIncomplete(acceptsIncomplete)
+		return service.ReplaceServiceInstance(options)
+	}
+
+	BeforeEach(func() {
+		testBroker = newTestBroker()
+		testServer = httptest.NewServer(broker.NewHandler(testBroker, &broker.Options{Username: "user", Password: "secret"}))
+		service = newService("user", "secret")
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Serve the catalog`, func() {
+		result, response, err := service.ListCatalog(service.NewListCatalogOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(result.Services).To(HaveLen(1))
+		Expect(*result.Services[0].Plans[1].ID).To(Equal("async-plan"))
+	})
+	It(`Reject invalid credentials`, func() {
+		_, response, err := newService("user", "wrong").ListCatalog(&openservicebrokerv1.ListCatalogOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(401))
+	})
+	It(`Provision, update and deprovision an instance synchronously`, func() {
+		result, response, err := provision("instance-1", "plan-id", false)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*result.DashboardURL).To(Equal("https://dashboard.example.com/instance-1"))
+		Expect(result.Operation).To(BeNil())
+
+		instance := testBroker.instances["instance-1"]
+		Expect(*instance.Context.AccountID).To(Equal("account-id"))
+		Expect(instance.Parameters).To(Equal(map[string]string{"size": "small"}))
+		Expect(instance.AcceptsIncomplete).ToNot(BeNil())
+		Expect(instance.Headers).To(HaveKey("Authorization"))
+
+		_, response, err = provision("instance-1", "plan-id", false)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		_, response, err = provision("instance-1", "other-plan", false)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		updateOptions := service.NewUpdateServiceInstanceOptions("instance-1")
+		updateOptions.SetServiceID("service-id")
+		updateOptions.SetPlanID("plan-2")
+		_, response, err = service.UpdateServiceInstance(updateOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*instance.PlanID).To(Equal("plan-2"))
+
+		deleteOptions := service.NewDeleteServiceInstanceOptions("service-id", "plan-2", "instance-1")
+		_, response, err = service.DeleteServiceInstance(deleteOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		_, response, err = service.DeleteServiceInstance(deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(410))
+	})
+	It(`Provision an instance asynchronously`, func() {
+		_, response, err := provision("instance-1", "async-plan", false)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(422))
+		Expect(response.Result).To(HaveKeyWithValue("error", "AsyncRequired"))
+
+		result, response, err := provision("instance-1", "async-plan", true)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*result.Operation).To(Equal("provision-instance-1"))
+
+		updateOptions := service.NewUpdateServiceInstanceOptions("instance-1")
+		updateOptions.SetServiceID("service-id")
+		_, response, err = service.UpdateServiceInstance(updateOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(422))
+		Expect(response.Result).To(HaveKeyWithValue("error", "ConcurrencyError"))
+
+		lastOperationOptions := service.NewGetLastOperationOptions("instance-1")
+		lastOperationOptions.SetOperation(*result.Operation)
+		lastOperation, _, err := service.GetLastOperation(lastOperationOptions)
+		Expect(err).To(BeNil())
+		Expect(*lastOperation.State).To(Equal(broker.LastOperationStateInProgress))
+		Expect(*lastOperation.Description).To(Equal("provision-instance-1"))
+
+		lastOperation, _, err = service.GetLastOperation(lastOperationOptions)
+		Expect(err).To(BeNil())
+		Expect(*lastOperation.State).To(Equal(broker.LastOperationStateSucceeded))
+
+		_, response, err = service.GetLastOperation(service.NewGetLastOperationOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(410))
+	})
+	It(`Report a broker that starts an asynchronous operation that is not accepted`, func() {
+		testServer.Close()
+		testServer = httptest.NewServer(broker.NewHandler(eagerBroker{testBroker}, &broker.Options{Username: "user", Password: "secret"}))
+		service = newService("user", "secret")
+
+		deleteOptions := service.NewDeleteServiceInstanceOptions("service-id", "plan-id", "instance-1")
+		_, response, err := service.DeleteServiceInstance(deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(500))
+		Expect(response.Result).To(HaveKeyWithValue("error", "AsyncStarted"))
+
+		deleteOptions.SetAcceptsIncomplete(true)
+		_, response, err = service.DeleteServiceInstance(deleteOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+	})
+	It(`Bind and unbind an instance`, func() {
+		_, _, err := provision("instance-1", "plan-id", false)
+		Expect(err).To(BeNil())
+
+		bindOptions := service.NewReplaceServiceBindingOptions("binding-1", "instance-1")
+		bindOptions.SetServiceID("service-id")
+		bindOptions.SetPlanID("plan-id")
+		bindOptions.SetBindResource(&openservicebrokerv1.BindResource{TargetCRN: core.StringPtr("crn:v1:target")})
+		result, response, err := service.ReplaceServiceBinding(bindOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(result.Credentials).To(Equal(map[string]interface{}{"apikey": "secret", "target": "crn:v1:target"}))
+
+		_, response, err = service.ReplaceServiceBinding(bindOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		bindOptions.SetParameters(map[string]string{"role": "Manager"})
+		_, response, err = service.ReplaceServiceBinding(bindOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		unbindOptions := service.NewDeleteServiceBindingOptions("binding-1", "instance-1", "plan-id", "service-id")
+		_, err = service.DeleteServiceBinding(unbindOptions)
+		Expect(err).To(BeNil())
+		response, err = service.DeleteServiceBinding(unbindOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(410))
+	})
+	It(`Get and update the state of an instance`, func() {
+		_, _, err := provision("instance-1", "plan-id", false)
+		Expect(err).To(BeNil())
+
+		updateStateOptions := service.NewReplaceServiceInstanceStateOptions("instance-1")
+		updateStateOptions.SetEnabled(false)
+		updateStateOptions.SetInitiatorID("initiator")
+		updated, _, err := service.ReplaceServiceInstanceState(updateStateOptions)
+		Expect(err).To(BeNil())
+		Expect(*updated.Enabled).To(BeFalse())
+
+		state, _, err := service.GetServiceInstanceState(service.NewGetServiceInstanceStateOptions("instance-1"))
+		Expect(err).To(BeNil())
+		Expect(*state.Enabled).To(BeFalse())
+
+		_, response, err := service.GetServiceInstanceState(service.NewGetServiceInstanceStateOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Reject malformed requests`, func() {
+		options := service.NewReplaceServiceInstanceOptions("instance-1")
+		options.SetPlanID("plan-id")
+		_, response, err := service.ReplaceServiceInstance(options)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		req, err := http.NewRequest(http.MethodPut, testServer.URL+"/v2/service_instances/instance-1?accepts_incomplete=maybe", nil)
+		Expect(err).To(BeNil())
+		req.SetBasicAuth("user", "secret")
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).To(BeNil())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(400))
+	})
+})
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openservicebrokerv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the Resp2079894Root.State property.
+// The state of the last operation on a service instance.
+const (
+	LastOperationStateInProgressConst = "in progress"
+	LastOperationStateSucceededConst  = "succeeded"
+	LastOperationStateFailedConst     = "failed"
+)
+
+// WaitForLastOperationOptions : The WaitForLastOperation options.
+// The zero value of each field selects its default.
+type WaitForLastOperationOptions struct {
+	// The delay before the second poll. Defaults to common.DefaultWaitInterval.
+	Interval time.Duration
+
+	// The upper bound of the delay between two polls. Defaults to common.DefaultWaitMaxInterval.
+	// A delay requested by the broker with a Retry-After header is not bounded.
+	MaxInterval time.Duration
+
+	// The factor applied to the delay after each poll. Defaults to common.DefaultWaitMultiplier;
+	// use a value of 1 to poll at a fixed interval.
+	Multiplier float64
+
+	// The maximum amount of time to wait. If not set, the wait is bounded only by the Context.
+	Timeout time.Duration
+
+	// True if the operation is a deprovision. A 410 Gone response then means that the operation succeeded.
+	Deprovision bool
+
+	// An optional callback invoked with the last operation retrieved by each poll.
+	Progress func(lastOperation *Resp2079894Root, attempt int)
+}
+
+// LastOperationResult : The final state of an asynchronous operation on a service instance.
+type LastOperationResult struct {
+	// The final state of the operation: "succeeded" or "failed".
+	State string
+
+	// The description of the final state provided by the broker, if any.
+	Description string
+
+	// True if the broker responded with 410 Gone to a poll of a deprovision operation.
+	InstanceGone bool
+
+	// The number of polls made.
+	Attempts int
+}
+
+// Succeeded returns true if the operation succeeded.
+func (result *LastOperationResult) Succeeded() bool {
+	return result.State == LastOperationStateSucceededConst
+}
+
+// LastOperationFailedError : The error returned by WaitForLastOperation when an operation failed.
+type LastOperationFailedError struct {
+	// The ID of the service instance.
+	InstanceID string
+
+	// The operation identifier provided by the broker, if any.
+	Operation string
+
+	// The description of the failure provided by the broker, if any.
+	Description string
+}
+
+func (e *LastOperationFailedError) Error() string {
+	msg := fmt.Sprintf("operation on service instance '%s' failed", e.InstanceID)
+	if e.Operation != "" {
+		msg = fmt.Sprintf("operation '%s' on service instance '%s' failed", e.Operation, e.InstanceID)
+	}
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// WaitForLastOperation polls the last operation on a service instance until it is no longer in progress.
+// It is typically called after ReplaceServiceInstance, UpdateServiceInstance or DeleteServiceInstance
+// returned a 202 status, with the operation identifier of their result:
+//
+//	getOptions := openServiceBrokerService.NewGetLastOperationOptions(instanceID)
+//	getOptions.SetOperation(*provisionResult.Operation)
+//	result, err := openServiceBrokerService.WaitForLastOperation(ctx, getOptions, nil)
+//
+// The last operation is retrieved with GetLastOperation, first immediately and then with an exponentially
+// increasing delay, or with the delay requested by the broker in a Retry-After header. The result holds
+// the final state and description of the operation. If the operation failed, the result is returned
+// along with an error that wraps a *LastOperationFailedError. An error is also returned if a poll fails, if the broker
+// reports an unknown state, or if the Context is cancelled or the timeout expires.
+func (openServiceBroker *OpenServiceBrokerV1) WaitForLastOperation(ctx context.Context, getLastOperationOptions *GetLastOperationOptions, opts *WaitForLastOperationOptions) (result *LastOperationResult, err error) {
+	err = core.ValidateNotNil(getLastOperationOptions, "getLastOperationOptions cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(getLastOperationOptions, "getLastOperationOptions")
+	if err != nil {
+		return
+	}
+	if opts == nil {
+		opts = &WaitForLastOperationOptions{}
+	}
+
+	backoff := common.NewBackoff(opts.Interval, opts.MaxInterval, opts.Multiplier)
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	instanceID := *getLastOperationOptions.InstanceID
+	for attempt := 1; ; attempt++ {
+		lastOperation, response, getErr := openServiceBroker.GetLastOperationWithContext(ctx, getLastOperationOptions)
+		if getErr != nil {
+			if response != nil && response.StatusCode == http.StatusGone && opts.Deprovision {
+				result = &LastOperationResult{State: LastOperationStateSucceededConst, InstanceGone: true, Attempts: attempt}
+				return
+			}
+			if ctx.Err() != nil {
+				err = common.WaitInterrupted(ctx, fmt.Sprintf("the last operation on service instance '%s'", instanceID))
+				return
+			}
+			err = core.RepurposeSDKProblem(getErr, "wait-get-error")
+			return
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(lastOperation, attempt)
+		}
+
+		state := core.StringNilMapper(lastOperation.State)
+		switch state {
+		case LastOperationStateSucceededConst, LastOperationStateFailedConst:
+			result = &LastOperationResult{
+				State:       state,
+				Description: core.StringNilMapper(lastOperation.Description),
+				Attempts:    attempt,
+			}
+			if state == LastOperationStateFailedConst {
+				err = core.SDKErrorf(&LastOperationFailedError{
+					InstanceID:  instanceID,
+					Operation:   core.StringNilMapper(getLastOperationOptions.Operation),
+					Description: result.Description,
+				}, "", "wait-operation-failed", common.GetComponentInfo())
+			}
+			return
+		case LastOperationStateInProgressConst:
+		default:
+			err = core.SDKErrorf(nil, fmt.Sprintf("unknown state '%s' of the last operation on service instance '%s'", state, instanceID), "wait-unknown-state", common.GetComponentInfo())
+			return
+		}
+
+		delay := backoff.Next()
+		if retryAfter, ok := parseRetryAfter(response.GetHeaders().Get("Retry-After"), time.Now()); ok {
+			delay = retryAfter
+		}
+
+		err = common.SleepContext(ctx, delay, fmt.Sprintf("the last operation on service instance '%s'", instanceID))
+		if err != nil {
+			return
+		}
+	}
+}
+
+// parseRetryAfter returns the delay specified by the value of a Retry-After header,
+// which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
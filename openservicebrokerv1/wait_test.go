@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openservicebrokerv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForLastOperation`, func() {
+	var testServer *httptest.Server
+	var responses []string
+	var retryAfter string
+	var requestNumber int
+	var requestTimes []time.Time
+
+	getLastOperationPath := "/v2/service_instances/testString/last_operation"
+	fastOptions := &openservicebrokerv1.WaitForLastOperationOptions{
+		Interval:    time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+		Multiplier:  2,
+	}
+
+	lastOperationJSON := func(state string, description string) string {
+		return fmt.Sprintf(`{"state":"%s","description":"%s"}`, state, description)
+	}
+
+	newService := func() *openservicebrokerv1.OpenServiceBrokerV1 {
+		openServiceBrokerService, serviceErr := openservicebrokerv1.NewOpenServiceBrokerV1(&openservicebrokerv1.OpenServiceBrokerV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(openServiceBrokerService).ToNot(BeNil())
+		return openServiceBrokerService
+	}
+
+	newOptions := func(service *openservicebrokerv1.OpenServiceBrokerV1) *openservicebrokerv1.GetLastOperationOptions {
+		getLastOperationOptions := service.NewGetLastOperationOptions("testString")
+		getLastOperationOptions.SetOperation("provision-1")
+		return getLastOperationOptions
+	}
+
+	BeforeEach(func() {
+		requestNumber = 0
+		requestTimes = nil
+		retryAfter = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal(getLastOperationPath))
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.Query()["operation"]).To(Equal([]string{"provision-1"}))
+
+			// Set mock response
+			response := responses[len(responses)-1]
+			if requestNumber < len(responses) {
+				response = responses[requestNumber]
+			}
+			requestNumber++
+			requestTimes = append(requestTimes, time.Now())
+			if response == "" {
+				res.WriteHeader(410)
+				return
+			}
+			if retryAfter != "" {
+				res.Header().Set("Retry-After", retryAfter)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, response)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Wait for an operation to succeed`, func() {
+		responses = []string{
+			lastOperationJSON("in progress", "Creating"),
+			lastOperationJSON("in progress", "Configuring"),
+			lastOperationJSON("succeeded", "Created"),
+		}
+		var descriptions []string
+		options := *fastOptions
+		options.Progress = func(lastOperation *openservicebrokerv1.Resp2079894Root, attempt int) {
+			Expect(attempt).To(Equal(len(descriptions) + 1))
+			descriptions = append(descriptions, *lastOperation.Description)
+		}
+
+		service := newService()
+		result, err := service.WaitForLastOperation(context.Background(), newOptions(service), &options)
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(result.Description).To(Equal("Created"))
+		Expect(result.Attempts).To(Equal(3))
+		Expect(result.InstanceGone).To(BeFalse())
+		Expect(descriptions).To(Equal([]string{"Creating", "Configuring", "Created"}))
+	})
+	It(`Return the description of a failed operation`, func() {
+		responses = []string{
+			lastOperationJSON("in progress", "Creating"),
+			lastOperationJSON("failed", "Quota exceeded"),
+		}
+
+		service := newService()
+		result, err := service.WaitForLastOperation(context.Background(), newOptions(service), fastOptions)
+		Expect(err).ToNot(BeNil())
+		var failedErr *openservicebrokerv1.LastOperationFailedError
+		Expect(errors.As(err, &failedErr)).To(BeTrue())
+		Expect(failedErr.Operation).To(Equal("provision-1"))
+		Expect(err.Error()).To(Equal("operation 'provision-1' on service instance 'testString' failed: Quota exceeded"))
+		Expect(result).ToNot(BeNil())
+		Expect(result.Succeeded()).To(BeFalse())
+		Expect(result.State).To(Equal(openservicebrokerv1.LastOperationStateFailedConst))
+		Expect(result.Description).To(Equal("Quota exceeded"))
+	})
+	It(`Honour the Retry-After header`, func() {
+		responses = []string{
+			lastOperationJSON("in progress", "Creating"),
+			lastOperationJSON("succeeded", "Created"),
+		}
+		retryAfter = "1"
+
+		service := newService()
+		result, err := service.WaitForLastOperation(context.Background(), newOptions(service), fastOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(requestTimes[1].Sub(requestTimes[0])).To(BeNumerically(">=", time.Second))
+	})
+	It(`Treat 410 Gone as the completion of a deprovision`, func() {
+		responses = []string{
+			lastOperationJSON("in progress", "Deleting"),
+			"",
+		}
+		options := *fastOptions
+		options.Deprovision = true
+
+		service := newService()
+		result, err := service.WaitForLastOperation(context.Background(), newOptions(service), &options)
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(result.InstanceGone).To(BeTrue())
+		Expect(result.Attempts).To(Equal(2))
+
+		requestNumber = 0
+		result, err = service.WaitForLastOperation(context.Background(), newOptions(service), fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It(`Fail when the broker reports an unknown state`, func() {
+		responses = []string{lastOperationJSON("pending", "")}
+
+		service := newService()
+		_, err := service.WaitForLastOperation(context.Background(), newOptions(service), fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown state 'pending'"))
+	})
+	It(`Fail when the context deadline expires`, func() {
+		responses = []string{lastOperationJSON("in progress", "Creating")}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		service := newService()
+		_, err := service.WaitForLastOperation(ctx, newOptions(service), fastOptions)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(err.Error()).To(HavePrefix("timed out waiting for the last operation on service instance 'testString'"))
+		Expect(requestNumber).To(BeNumerically(">", 1))
+	})
+	It(`Fail when the context is cancelled`, func() {
+		responses = []string{lastOperationJSON("in progress", "Creating")}
+		ctx, cancel := context.WithCancel(context.Background())
+		options := *fastOptions
+		options.Progress = func(lastOperation *openservicebrokerv1.Resp2079894Root, attempt int) {
+			cancel()
+		}
+
+		service := newService()
+		_, err := service.WaitForLastOperation(ctx, newOptions(service), &options)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(err.Error()).To(HavePrefix("cancelled while waiting for the last operation on service instance 'testString'"))
+	})
+	It(`Fail when the timeout expires before the delay requested by the broker`, func() {
+		responses = []string{lastOperationJSON("in progress", "Creating")}
+		retryAfter = "60"
+		options := *fastOptions
+		options.Timeout = 50 * time.Millisecond
+
+		service := newService()
+		_, err := service.WaitForLastOperation(context.Background(), newOptions(service), &options)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(requestNumber).To(Equal(1))
+	})
+	It(`Invoke WaitForLastOperation with invalid parameters`, func() {
+		responses = []string{lastOperationJSON("succeeded", "")}
+		service := newService()
+
+		_, err := service.WaitForLastOperation(context.Background(), nil, nil)
+		Expect(err).ToNot(BeNil())
+		_, err = service.WaitForLastOperation(context.Background(), &openservicebrokerv1.GetLastOperationOptions{}, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requestNumber).To(Equal(0))
+	})
+})
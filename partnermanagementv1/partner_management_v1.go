@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -1717,3 +1718,10 @@ func (pager *GetResourceUsageReportPager) GetAll() (allItems []PartnerUsageRepor
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetResourceUsageReportPager) Items(ctx context.Context) iter.Seq2[PartnerUsageReport, error] {
+	return common.Items[PartnerUsageReport](ctx, pager)
+}
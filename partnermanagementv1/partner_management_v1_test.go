@@ -541,6 +541,36 @@ var _ = Describe(`PartnerManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.Items successfully`, func() {
+				partnerManagementService, serviceErr := partnermanagementv1.NewPartnerManagementV1(&partnermanagementv1.PartnerManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(partnerManagementService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &partnermanagementv1.GetResourceUsageReportOptions{
+					PartnerID:  core.StringPtr("testString"),
+					ResellerID: core.StringPtr("testString"),
+					CustomerID: core.StringPtr("testString"),
+					Children:   core.BoolPtr(false),
+					Month:      core.StringPtr("2024-01"),
+					Viewpoint:  core.StringPtr("DISTRIBUTOR"),
+					Recurse:    core.BoolPtr(false),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := partnerManagementService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []partnermanagementv1.PartnerUsageReport
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetBillingOptions(getBillingOptionsOptions *GetBillingOptionsOptions) - Operation response error`, func() {
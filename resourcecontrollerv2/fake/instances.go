@@ -0,0 +1,367 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// Operation types reported in the last operation of a resource instance.
+const (
+	OperationTypeCreate = "create"
+	OperationTypeUpdate = "update"
+	OperationTypeDelete = "delete"
+)
+
+// reclamationPeriod is the time after which a reclamation would be carried out by the real service.
+const reclamationPeriod = 7 * 24 * time.Hour
+
+// handleInstances handles the requests on /v2/resource_instances.
+func (server *Server) handleInstances(req *http.Request, path []string) (int, interface{}, error) {
+	if len(path) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			return server.listInstances(req)
+		case http.MethodPost:
+			return server.createInstance(req)
+		}
+		return 0, nil, methodNotAllowed(req)
+	}
+
+	inst := server.findInstance(path[0])
+	if inst == nil {
+		return 0, nil, notFound("resource instance", path[0])
+	}
+
+	if len(path) == 1 {
+		switch req.Method {
+		case http.MethodGet:
+			inst.poll(server.now())
+			return http.StatusOK, inst.ResourceInstance, nil
+		case http.MethodPatch:
+			return server.updateInstance(req, inst)
+		case http.MethodDelete:
+			return server.deleteInstance(req, inst)
+		}
+		return 0, nil, methodNotAllowed(req)
+	}
+
+	if len(path) == 2 {
+		switch path[1] + " " + req.Method {
+		case "resource_keys GET":
+			keys := filter(server.activeKeys(), func(key *resourcecontrollerv2.ResourceKey) bool {
+				return core.StringNilMapper(key.SourceCRN) == *inst.CRN
+			})
+			return listResponse(server, req, keys)
+		case "resource_aliases GET":
+			aliases := filter(server.activeAliases(), func(alias *resourcecontrollerv2.ResourceAlias) bool {
+				return core.StringNilMapper(alias.ResourceInstanceID) == *inst.ID
+			})
+			return listResponse(server, req, aliases)
+		case "lock POST":
+			inst.Locked = core.BoolPtr(true)
+			inst.UpdatedAt = server.now()
+			return http.StatusOK, inst.ResourceInstance, nil
+		case "lock DELETE":
+			inst.Locked = core.BoolPtr(false)
+			inst.UpdatedAt = server.now()
+			return http.StatusOK, inst.ResourceInstance, nil
+		case "last_operation DELETE":
+			if !inst.inProgress() {
+				return 0, nil, newError(http.StatusUnprocessableEntity, "no operation in progress on resource instance '%s'", path[0])
+			}
+			inst.finishOperation(resourcecontrollerv2.ResourceInstanceLastOperationStateFailedConst, "The operation was cancelled", server.now())
+			return http.StatusOK, inst.ResourceInstance, nil
+		}
+	}
+	return 0, nil, newError(http.StatusNotFound, "path '%s' not found", req.URL.Path)
+}
+
+func (server *Server) listInstances(req *http.Request) (int, interface{}, error) {
+	states := []string{
+		resourcecontrollerv2.ResourceInstanceStateActiveConst,
+		resourcecontrollerv2.ResourceInstanceStateProvisioningConst,
+	}
+	if state := req.URL.Query().Get("state"); state != "" {
+		states = []string{state}
+	}
+
+	var instances []*resourcecontrollerv2.ResourceInstance
+	for _, inst := range server.instances {
+		if slices.Contains(states, core.StringNilMapper(inst.State)) &&
+			matches(req, "guid", inst.GUID) &&
+			matches(req, "name", inst.Name) &&
+			matches(req, "resource_group_id", inst.ResourceGroupID) &&
+			matches(req, "resource_id", inst.ResourceID) &&
+			matches(req, "resource_plan_id", inst.ResourcePlanID) &&
+			matches(req, "type", inst.Type) &&
+			matches(req, "sub_type", inst.SubType) {
+			instances = append(instances, inst.ResourceInstance)
+		}
+	}
+	return listResponse(server, req, instances)
+}
+
+func (server *Server) createInstance(req *http.Request) (int, interface{}, error) {
+	var body resourcecontrollerv2.CreateResourceInstanceOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{{"name", body.Name}, {"target", body.Target}, {"resource_group", body.ResourceGroup}, {"resource_plan_id", body.ResourcePlanID}} {
+		if err := requireField(field.name, field.value); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	now := server.now()
+	guid := newGUID()
+	crn := server.newCRN(*body.Target, guid, "", "")
+	path := "/v2/resource_instances/" + guid
+	inst := &instance{
+		ResourceInstance: &resourcecontrollerv2.ResourceInstance{
+			ID:                  &crn,
+			GUID:                &guid,
+			CRN:                 &crn,
+			URL:                 core.StringPtr(path),
+			Name:                body.Name,
+			RegionID:            body.Target,
+			AccountID:           core.StringPtr(server.options.AccountID),
+			ResourcePlanID:      body.ResourcePlanID,
+			ResourceGroupID:     body.ResourceGroup,
+			ResourceGroupCRN:    core.StringPtr("crn:v1:bluemix:public:resource-controller::a/" + server.options.AccountID + "::resource-group:" + *body.ResourceGroup),
+			ResourceID:          core.StringPtr(server.options.ServiceName),
+			TargetCRN:           core.StringPtr("crn:v1:bluemix:public:globalcatalog::::deployment:" + *body.ResourcePlanID + ":" + *body.Target),
+			Parameters:          body.Parameters,
+			AllowCleanup:        core.BoolPtr(body.AllowCleanup != nil && *body.AllowCleanup),
+			Type:                core.StringPtr("service_instance"),
+			Locked:              core.BoolPtr(req.Header.Get("Entity-Lock") == "true"),
+			CreatedAt:           now,
+			CreatedBy:           core.StringPtr(server.options.UserID),
+			UpdatedAt:           now,
+			UpdatedBy:           core.StringPtr(server.options.UserID),
+			ResourceAliasesURL:  core.StringPtr(path + "/resource_aliases"),
+			ResourceBindingsURL: core.StringPtr(path + "/resource_bindings"),
+			ResourceKeysURL:     core.StringPtr(path + "/resource_keys"),
+			PlanHistory: []resourcecontrollerv2.PlanHistoryItem{{
+				ResourcePlanID: body.ResourcePlanID,
+				StartDate:      now,
+				RequestorID:    core.StringPtr(server.options.UserID),
+			}},
+		},
+	}
+	server.instances = append(server.instances, inst)
+
+	status := server.startOperation(inst, OperationTypeCreate, resourcecontrollerv2.ResourceInstanceStateProvisioningConst, resourcecontrollerv2.ResourceInstanceStateActiveConst, http.StatusCreated)
+	return status, inst.ResourceInstance, nil
+}
+
+func (server *Server) updateInstance(req *http.Request, inst *instance) (int, interface{}, error) {
+	if err := server.checkModifiable(inst); err != nil {
+		return 0, nil, err
+	}
+
+	var body resourcecontrollerv2.UpdateResourceInstanceOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+
+	now := server.now()
+	if body.Name != nil {
+		inst.Name = body.Name
+	}
+	if body.Parameters != nil {
+		inst.Parameters = body.Parameters
+	}
+	if body.AllowCleanup != nil {
+		inst.AllowCleanup = body.AllowCleanup
+	}
+	if body.ResourcePlanID != nil && *body.ResourcePlanID != *inst.ResourcePlanID {
+		inst.ResourcePlanID = body.ResourcePlanID
+		inst.PlanHistory = append(inst.PlanHistory, resourcecontrollerv2.PlanHistoryItem{
+			ResourcePlanID: body.ResourcePlanID,
+			StartDate:      now,
+			RequestorID:    core.StringPtr(server.options.UserID),
+		})
+	}
+	inst.UpdatedAt = now
+	inst.UpdatedBy = core.StringPtr(server.options.UserID)
+
+	status := server.startOperation(inst, OperationTypeUpdate, *inst.State, *inst.State, http.StatusOK)
+	return status, inst.ResourceInstance, nil
+}
+
+func (server *Server) deleteInstance(req *http.Request, inst *instance) (int, interface{}, error) {
+	if err := server.checkModifiable(inst); err != nil {
+		return 0, nil, err
+	}
+
+	keys := filter(server.activeKeys(), func(key *resourcecontrollerv2.ResourceKey) bool {
+		return core.StringNilMapper(key.SourceCRN) == *inst.CRN
+	})
+	aliases := filter(server.activeAliases(), func(alias *resourcecontrollerv2.ResourceAlias) bool {
+		return core.StringNilMapper(alias.ResourceInstanceID) == *inst.ID
+	})
+	if len(keys)+len(aliases) > 0 {
+		if req.URL.Query().Get("recursive") != "true" {
+			return 0, nil, newError(http.StatusBadRequest, "resource instance '%s' has dependent resource keys or aliases; set 'recursive' to delete them", *inst.ID)
+		}
+		for _, key := range keys {
+			server.removeKey(key)
+		}
+		for _, alias := range aliases {
+			server.removeAlias(alias)
+		}
+	}
+
+	now := server.now()
+	inst.UpdatedAt = now
+	inst.UpdatedBy = core.StringPtr(server.options.UserID)
+
+	if server.options.Reclamation {
+		reclaimAt := strfmt.DateTime(time.Time(*now).Add(reclamationPeriod))
+		inst.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStatePendingReclamationConst)
+		inst.ScheduledReclaimAt = &reclaimAt
+		inst.ScheduledReclaimBy = core.StringPtr(server.options.UserID)
+		inst.LastOperation = &resourcecontrollerv2.ResourceInstanceLastOperation{
+			Type:        core.StringPtr(OperationTypeDelete),
+			State:       core.StringPtr(resourcecontrollerv2.ResourceInstanceLastOperationStateSucceededConst),
+			Async:       core.BoolPtr(false),
+			Description: core.StringPtr("The resource instance is pending reclamation"),
+			Cancelable:  core.BoolPtr(false),
+			Poll:        core.BoolPtr(false),
+		}
+		guid := newGUID()
+		server.reclamations = append(server.reclamations, &resourcecontrollerv2.Reclamation{
+			ID:                 &guid,
+			EntityID:           inst.GUID,
+			EntityTypeID:       core.StringPtr("resource_instance"),
+			EntityCRN:          inst.CRN,
+			ResourceInstanceID: inst.GUID,
+			ResourceGroupID:    inst.ResourceGroupID,
+			AccountID:          inst.AccountID,
+			State:              core.StringPtr(ReclamationStateScheduled),
+			TargetTime:         core.StringPtr(reclaimAt.String()),
+			CreatedAt:          now,
+			CreatedBy:          core.StringPtr(server.options.UserID),
+			UpdatedAt:          now,
+			UpdatedBy:          core.StringPtr(server.options.UserID),
+		})
+		return http.StatusAccepted, nil, nil
+	}
+
+	status := server.startOperation(inst, OperationTypeDelete, *inst.State, resourcecontrollerv2.ResourceInstanceStateRemovedConst, http.StatusNoContent)
+	return status, nil, nil
+}
+
+// checkModifiable returns an error if "inst" is locked or has an operation in progress.
+func (server *Server) checkModifiable(inst *instance) error {
+	if inst.Locked != nil && *inst.Locked {
+		return newError(http.StatusUnprocessableEntity, "resource instance '%s' is locked", *inst.ID)
+	}
+	if inst.inProgress() {
+		return newError(http.StatusUnprocessableEntity, "an operation is in progress on resource instance '%s'", *inst.ID)
+	}
+	if *inst.State == resourcecontrollerv2.ResourceInstanceStateRemovedConst {
+		return newError(http.StatusGone, "resource instance '%s' has been removed", *inst.ID)
+	}
+	return nil
+}
+
+// startOperation starts an operation of type "opType" on "inst", which completes immediately unless the server
+// is configured for asynchronous operations. It returns the status code of the response: "syncStatus" if the
+// operation completed, or 202 (Accepted) otherwise.
+func (server *Server) startOperation(inst *instance, opType string, pendingState string, finalState string, syncStatus int) int {
+	inst.LastOperation = &resourcecontrollerv2.ResourceInstanceLastOperation{
+		Type:       core.StringPtr(opType),
+		State:      core.StringPtr(resourcecontrollerv2.ResourceInstanceLastOperationStateInProgressConst),
+		Async:      core.BoolPtr(server.options.AsyncPolls > 0),
+		Cancelable: core.BoolPtr(server.options.AsyncPolls > 0),
+		Poll:       core.BoolPtr(server.options.AsyncPolls > 0),
+	}
+	inst.State = core.StringPtr(pendingState)
+	inst.finalState = finalState
+	inst.pendingPolls = server.options.AsyncPolls
+
+	if server.options.AsyncPolls == 0 {
+		inst.finishOperation(resourcecontrollerv2.ResourceInstanceLastOperationStateSucceededConst, "", server.now())
+		return syncStatus
+	}
+	inst.LastOperation.Description = core.StringPtr("The " + opType + " operation is in progress")
+	return http.StatusAccepted
+}
+
+// findInstance returns the resource instance whose ID, GUID or CRN is "id".
+func (server *Server) findInstance(id string) *instance {
+	for _, inst := range server.instances {
+		if hasID(id, inst.ID, inst.GUID, inst.CRN) {
+			return inst
+		}
+	}
+	return nil
+}
+
+// inProgress returns true if an operation is in progress on the instance.
+func (inst *instance) inProgress() bool {
+	return inst.LastOperation != nil && core.StringNilMapper(inst.LastOperation.State) == resourcecontrollerv2.ResourceInstanceLastOperationStateInProgressConst
+}
+
+// poll advances the operation in progress on the instance, if any.
+func (inst *instance) poll(now *strfmt.DateTime) {
+	if !inst.inProgress() {
+		return
+	}
+	inst.pendingPolls--
+	if inst.pendingPolls <= 0 {
+		inst.finishOperation(resourcecontrollerv2.ResourceInstanceLastOperationStateSucceededConst, "", now)
+	}
+}
+
+// finishOperation completes the operation in progress on the instance with the specified state.
+func (inst *instance) finishOperation(state string, description string, now *strfmt.DateTime) {
+	opType := core.StringNilMapper(inst.LastOperation.Type)
+	if description == "" {
+		description = "The " + opType + " operation " + state
+	}
+	inst.LastOperation.State = core.StringPtr(state)
+	inst.LastOperation.Description = core.StringPtr(description)
+	inst.LastOperation.Cancelable = core.BoolPtr(false)
+	inst.LastOperation.Poll = core.BoolPtr(false)
+	inst.pendingPolls = 0
+	inst.UpdatedAt = now
+
+	switch {
+	case state == resourcecontrollerv2.ResourceInstanceLastOperationStateSucceededConst:
+		inst.State = core.StringPtr(inst.finalState)
+		if inst.finalState == resourcecontrollerv2.ResourceInstanceStateRemovedConst {
+			inst.DeletedAt = now
+			inst.DeletedBy = inst.UpdatedBy
+		}
+	case opType == OperationTypeCreate:
+		inst.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateFailedConst)
+	default:
+		inst.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateActiveConst)
+	}
+}
@@ -0,0 +1,465 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common/crn"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// The state of active keys, aliases and bindings.
+const stateActive = "active"
+
+// handleKeys handles the requests on /v2/resource_keys.
+func (server *Server) handleKeys(req *http.Request, path []string) (int, interface{}, error) {
+	if len(path) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			keys := filter(server.activeKeys(), func(key *resourcecontrollerv2.ResourceKey) bool {
+				return matches(req, "guid", key.GUID) &&
+					matches(req, "name", key.Name) &&
+					matches(req, "resource_group_id", key.ResourceGroupID) &&
+					matches(req, "resource_id", key.ResourceID)
+			})
+			return listResponse(server, req, keys)
+		case http.MethodPost:
+			return server.createKey(req)
+		}
+		return 0, nil, methodNotAllowed(req)
+	}
+
+	var key *resourcecontrollerv2.ResourceKey
+	for _, k := range server.keys {
+		if hasID(path[0], k.ID, k.GUID, k.CRN) {
+			key = k
+		}
+	}
+	if key == nil || len(path) > 1 {
+		return 0, nil, notFound("resource key", path[0])
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, key, nil
+	case http.MethodPatch:
+		name, err := decodeName(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		key.Name = name
+		key.UpdatedAt = server.now()
+		key.UpdatedBy = core.StringPtr(server.options.UserID)
+		return http.StatusOK, key, nil
+	case http.MethodDelete:
+		if *key.State != stateActive {
+			return 0, nil, newError(http.StatusGone, "resource key '%s' has been removed", path[0])
+		}
+		server.removeKey(key)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed(req)
+}
+
+func (server *Server) createKey(req *http.Request) (int, interface{}, error) {
+	var body resourcecontrollerv2.CreateResourceKeyOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+	if err := requireField("name", body.Name); err != nil {
+		return 0, nil, err
+	}
+	if err := requireField("source", body.Source); err != nil {
+		return 0, nil, err
+	}
+
+	// The source of a key is either a resource instance or a resource alias.
+	var sourceCRN, resourceGroupID, instanceURL, aliasURL *string
+	if inst := server.findInstance(*body.Source); inst != nil && *inst.State == resourcecontrollerv2.ResourceInstanceStateActiveConst {
+		sourceCRN, resourceGroupID, instanceURL = inst.CRN, inst.ResourceGroupID, inst.URL
+	} else if alias := server.findActiveAlias(*body.Source); alias != nil {
+		sourceCRN, resourceGroupID, aliasURL = alias.CRN, alias.ResourceGroupID, alias.URL
+	} else {
+		return 0, nil, newError(http.StatusBadRequest, "source '%s' is not an active resource instance or alias", *body.Source)
+	}
+
+	var serviceIDCRN *string
+	if body.Parameters != nil {
+		serviceIDCRN = body.Parameters.ServiceidCRN
+	}
+
+	now := server.now()
+	guid := newGUID()
+	keyCRN := server.childCRN(*sourceCRN, "resource-key", guid)
+	key := &resourcecontrollerv2.ResourceKey{
+		ID:                  &keyCRN,
+		GUID:                &guid,
+		CRN:                 &keyCRN,
+		URL:                 core.StringPtr("/v2/resource_keys/" + guid),
+		Name:                body.Name,
+		SourceCRN:           sourceCRN,
+		State:               core.StringPtr(stateActive),
+		AccountID:           core.StringPtr(server.options.AccountID),
+		ResourceGroupID:     resourceGroupID,
+		ResourceID:          core.StringPtr(server.options.ServiceName),
+		IamCompatible:       core.BoolPtr(true),
+		Credentials:         server.newCredentials(*body.Name, body.Role, serviceIDCRN),
+		ResourceInstanceURL: instanceURL,
+		ResourceAliasURL:    aliasURL,
+		CreatedAt:           now,
+		CreatedBy:           core.StringPtr(server.options.UserID),
+		UpdatedAt:           now,
+		UpdatedBy:           core.StringPtr(server.options.UserID),
+	}
+	server.keys = append(server.keys, key)
+	return http.StatusCreated, key, nil
+}
+
+// handleAliases handles the requests on /v2/resource_aliases.
+func (server *Server) handleAliases(req *http.Request, path []string) (int, interface{}, error) {
+	if len(path) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			aliases := filter(server.activeAliases(), func(alias *resourcecontrollerv2.ResourceAlias) bool {
+				return matches(req, "guid", alias.GUID) &&
+					matches(req, "name", alias.Name) &&
+					matches(req, "resource_instance_id", alias.ResourceInstanceID) &&
+					matches(req, "region_instance_id", alias.RegionInstanceID) &&
+					matches(req, "resource_id", alias.ResourceID) &&
+					matches(req, "resource_group_id", alias.ResourceGroupID)
+			})
+			return listResponse(server, req, aliases)
+		case http.MethodPost:
+			return server.createAlias(req)
+		}
+		return 0, nil, methodNotAllowed(req)
+	}
+
+	var alias *resourcecontrollerv2.ResourceAlias
+	for _, a := range server.aliases {
+		if hasID(path[0], a.ID, a.GUID, a.CRN) {
+			alias = a
+		}
+	}
+	if alias == nil {
+		return 0, nil, notFound("resource alias", path[0])
+	}
+
+	if len(path) == 2 && path[1] == "resource_bindings" && req.Method == http.MethodGet {
+		bindings := filter(server.activeBindings(), func(binding *resourcecontrollerv2.ResourceBinding) bool {
+			return core.StringNilMapper(binding.SourceCRN) == *alias.CRN
+		})
+		return listResponse(server, req, bindings)
+	}
+	if len(path) > 1 {
+		return 0, nil, newError(http.StatusNotFound, "path '%s' not found", req.URL.Path)
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, alias, nil
+	case http.MethodPatch:
+		name, err := decodeName(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		alias.Name = name
+		alias.UpdatedAt = server.now()
+		alias.UpdatedBy = core.StringPtr(server.options.UserID)
+		return http.StatusOK, alias, nil
+	case http.MethodDelete:
+		if *alias.State != stateActive {
+			return 0, nil, newError(http.StatusGone, "resource alias '%s' has been removed", path[0])
+		}
+		if req.URL.Query().Get("recursive") != "true" {
+			for _, binding := range server.activeBindings() {
+				if core.StringNilMapper(binding.SourceCRN) == *alias.CRN {
+					return 0, nil, newError(http.StatusBadRequest, "resource alias '%s' has dependent resource bindings; set 'recursive' to delete them", path[0])
+				}
+			}
+		}
+		server.removeAlias(alias)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed(req)
+}
+
+func (server *Server) createAlias(req *http.Request) (int, interface{}, error) {
+	var body resourcecontrollerv2.CreateResourceAliasOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{{"name", body.Name}, {"source", body.Source}, {"target", body.Target}} {
+		if err := requireField(field.name, field.value); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	inst := server.findInstance(*body.Source)
+	if inst == nil || *inst.State != resourcecontrollerv2.ResourceInstanceStateActiveConst {
+		return 0, nil, newError(http.StatusBadRequest, "source '%s' is not an active resource instance", *body.Source)
+	}
+
+	now := server.now()
+	guid := newGUID()
+	aliasCRN := server.childCRN(*inst.CRN, "resource-alias", guid)
+	path := "/v2/resource_aliases/" + guid
+	alias := &resourcecontrollerv2.ResourceAlias{
+		ID:                  &aliasCRN,
+		GUID:                &guid,
+		CRN:                 &aliasCRN,
+		URL:                 core.StringPtr(path),
+		Name:                body.Name,
+		ResourceInstanceID:  inst.ID,
+		TargetCRN:           body.Target,
+		AccountID:           core.StringPtr(server.options.AccountID),
+		ResourceID:          inst.ResourceID,
+		ResourceGroupID:     inst.ResourceGroupID,
+		RegionInstanceID:    inst.GUID,
+		RegionInstanceCRN:   inst.CRN,
+		State:               core.StringPtr(stateActive),
+		ResourceInstanceURL: inst.URL,
+		ResourceBindingsURL: core.StringPtr(path + "/resource_bindings"),
+		ResourceKeysURL:     core.StringPtr(path + "/resource_keys"),
+		CreatedAt:           now,
+		CreatedBy:           core.StringPtr(server.options.UserID),
+		UpdatedAt:           now,
+		UpdatedBy:           core.StringPtr(server.options.UserID),
+	}
+	server.aliases = append(server.aliases, alias)
+	return http.StatusCreated, alias, nil
+}
+
+// handleBindings handles the requests on /v2/resource_bindings.
+func (server *Server) handleBindings(req *http.Request, path []string) (int, interface{}, error) {
+	if len(path) == 0 {
+		switch req.Method {
+		case http.MethodGet:
+			bindings := filter(server.activeBindings(), func(binding *resourcecontrollerv2.ResourceBinding) bool {
+				return matches(req, "guid", binding.GUID) &&
+					matches(req, "name", binding.Name) &&
+					matches(req, "resource_group_id", binding.ResourceGroupID) &&
+					matches(req, "resource_id", binding.ResourceID) &&
+					matches(req, "region_binding_id", binding.RegionBindingID)
+			})
+			return listResponse(server, req, bindings)
+		case http.MethodPost:
+			return server.createBinding(req)
+		}
+		return 0, nil, methodNotAllowed(req)
+	}
+
+	var binding *resourcecontrollerv2.ResourceBinding
+	for _, b := range server.bindings {
+		if hasID(path[0], b.ID, b.GUID, b.CRN) {
+			binding = b
+		}
+	}
+	if binding == nil || len(path) > 1 {
+		return 0, nil, notFound("resource binding", path[0])
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, binding, nil
+	case http.MethodPatch:
+		name, err := decodeName(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		binding.Name = name
+		binding.UpdatedAt = server.now()
+		binding.UpdatedBy = core.StringPtr(server.options.UserID)
+		return http.StatusOK, binding, nil
+	case http.MethodDelete:
+		if *binding.State != stateActive {
+			return 0, nil, newError(http.StatusGone, "resource binding '%s' has been removed", path[0])
+		}
+		server.removeBinding(binding)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed(req)
+}
+
+func (server *Server) createBinding(req *http.Request) (int, interface{}, error) {
+	var body resourcecontrollerv2.CreateResourceBindingOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+	if err := requireField("source", body.Source); err != nil {
+		return 0, nil, err
+	}
+	if err := requireField("target", body.Target); err != nil {
+		return 0, nil, err
+	}
+
+	alias := server.findActiveAlias(*body.Source)
+	if alias == nil {
+		return 0, nil, newError(http.StatusBadRequest, "source '%s' is not an active resource alias", *body.Source)
+	}
+
+	var serviceIDCRN *string
+	if body.Parameters != nil {
+		serviceIDCRN = body.Parameters.ServiceidCRN
+	}
+	name := body.Name
+	if name == nil {
+		name = core.StringPtr(*alias.Name + "-binding")
+	}
+
+	now := server.now()
+	guid := newGUID()
+	bindingCRN := server.childCRN(*alias.CRN, "resource-binding", guid)
+	binding := &resourcecontrollerv2.ResourceBinding{
+		ID:               &bindingCRN,
+		GUID:             &guid,
+		CRN:              &bindingCRN,
+		URL:              core.StringPtr("/v2/resource_bindings/" + guid),
+		Name:             name,
+		SourceCRN:        alias.CRN,
+		TargetCRN:        body.Target,
+		RegionBindingID:  &guid,
+		RegionBindingCRN: &bindingCRN,
+		AccountID:        core.StringPtr(server.options.AccountID),
+		ResourceGroupID:  alias.ResourceGroupID,
+		ResourceID:       alias.ResourceID,
+		State:            core.StringPtr(stateActive),
+		IamCompatible:    core.BoolPtr(true),
+		Credentials:      server.newCredentials(*name, body.Role, serviceIDCRN),
+		ResourceAliasURL: alias.URL,
+		CreatedAt:        now,
+		CreatedBy:        core.StringPtr(server.options.UserID),
+		UpdatedAt:        now,
+		UpdatedBy:        core.StringPtr(server.options.UserID),
+	}
+	server.bindings = append(server.bindings, binding)
+	return http.StatusCreated, binding, nil
+}
+
+// activeKeys returns the resource keys that have not been removed.
+func (server *Server) activeKeys() []*resourcecontrollerv2.ResourceKey {
+	return filter(server.keys, func(key *resourcecontrollerv2.ResourceKey) bool {
+		return *key.State == stateActive
+	})
+}
+
+// activeAliases returns the resource aliases that have not been removed.
+func (server *Server) activeAliases() []*resourcecontrollerv2.ResourceAlias {
+	return filter(server.aliases, func(alias *resourcecontrollerv2.ResourceAlias) bool {
+		return *alias.State == stateActive
+	})
+}
+
+// activeBindings returns the resource bindings that have not been removed.
+func (server *Server) activeBindings() []*resourcecontrollerv2.ResourceBinding {
+	return filter(server.bindings, func(binding *resourcecontrollerv2.ResourceBinding) bool {
+		return *binding.State == stateActive
+	})
+}
+
+// findActiveAlias returns the active resource alias whose ID, GUID or CRN is "id".
+func (server *Server) findActiveAlias(id string) *resourcecontrollerv2.ResourceAlias {
+	for _, alias := range server.activeAliases() {
+		if hasID(id, alias.ID, alias.GUID, alias.CRN) {
+			return alias
+		}
+	}
+	return nil
+}
+
+// removeKey marks a resource key as removed.
+func (server *Server) removeKey(key *resourcecontrollerv2.ResourceKey) {
+	key.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateRemovedConst)
+	key.DeletedAt = server.now()
+	key.DeletedBy = core.StringPtr(server.options.UserID)
+}
+
+// removeAlias marks a resource alias, along with its keys and bindings, as removed.
+func (server *Server) removeAlias(alias *resourcecontrollerv2.ResourceAlias) {
+	for _, key := range server.activeKeys() {
+		if core.StringNilMapper(key.SourceCRN) == *alias.CRN {
+			server.removeKey(key)
+		}
+	}
+	for _, binding := range server.activeBindings() {
+		if core.StringNilMapper(binding.SourceCRN) == *alias.CRN {
+			server.removeBinding(binding)
+		}
+	}
+	alias.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateRemovedConst)
+	alias.DeletedAt = server.now()
+	alias.DeletedBy = core.StringPtr(server.options.UserID)
+}
+
+// removeBinding marks a resource binding as removed.
+func (server *Server) removeBinding(binding *resourcecontrollerv2.ResourceBinding) {
+	binding.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateRemovedConst)
+	binding.DeletedAt = server.now()
+	binding.DeletedBy = core.StringPtr(server.options.UserID)
+}
+
+// childCRN returns the CRN of a resource of type "resourceType" that belongs to the resource identified by "parentCRN".
+func (server *Server) childCRN(parentCRN string, resourceType string, guid string) string {
+	parent, err := crn.Parse(parentCRN)
+	if err != nil {
+		return server.newCRN("global", guid, resourceType, guid)
+	}
+	parent.ResourceType = resourceType
+	parent.Resource = guid
+	return parent.String()
+}
+
+// newCredentials returns the credentials of a new key or binding.
+func (server *Server) newCredentials(name string, role *string, serviceIDCRN *string) *resourcecontrollerv2.Credentials {
+	roleCRN := "crn:v1:bluemix:public:iam::::serviceRole:Manager"
+	if role != nil && *role != "" {
+		roleCRN = *role
+		if !strings.HasPrefix(roleCRN, crn.Prefix+":") {
+			roleCRN = "crn:v1:bluemix:public:iam::::serviceRole:" + roleCRN
+		}
+	}
+	if serviceIDCRN == nil {
+		serviceIDCRN = core.StringPtr("crn:v1:bluemix:public:iam-identity::a/" + server.options.AccountID + "::serviceid:ServiceId-" + newGUID())
+	}
+	return &resourcecontrollerv2.Credentials{
+		Apikey:               core.StringPtr(strings.ReplaceAll(newGUID(), "-", "")),
+		IamApikeyName:        core.StringPtr(name),
+		IamApikeyDescription: core.StringPtr("Auto-generated for key " + name),
+		IamRoleCRN:           &roleCRN,
+		IamServiceidCRN:      serviceIDCRN,
+	}
+}
+
+// decodeName decodes the body of a request that renames a key, alias or binding.
+func decodeName(req *http.Request) (*string, error) {
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if err := decodeBody(req, &body); err != nil {
+		return nil, err
+	}
+	if err := requireField("name", body.Name); err != nil {
+		return nil, err
+	}
+	return body.Name, nil
+}
@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// Reclamation states.
+const (
+	ReclamationStateScheduled  = "SCHEDULED"
+	ReclamationStateRestoring  = "RESTORING"
+	ReclamationStateReclaiming = "RECLAIMING"
+)
+
+// Reclamation actions.
+const (
+	ReclamationActionRestore = "restore"
+	ReclamationActionReclaim = "reclaim"
+)
+
+// handleReclamations handles the requests on /v1/reclamations.
+func (server *Server) handleReclamations(req *http.Request, path []string) (int, interface{}, error) {
+	if len(path) == 0 {
+		if req.Method != http.MethodGet {
+			return 0, nil, methodNotAllowed(req)
+		}
+		reclamations := filter(server.reclamations, func(reclamation *resourcecontrollerv2.Reclamation) bool {
+			return *reclamation.State == ReclamationStateScheduled &&
+				matches(req, "account_id", reclamation.AccountID) &&
+				matches(req, "resource_instance_id", reclamation.ResourceInstanceID) &&
+				matches(req, "resource_group_id", reclamation.ResourceGroupID)
+		})
+		return http.StatusOK, &resourcecontrollerv2.ReclamationsList{Resources: derefAll(reclamations)}, nil
+	}
+
+	if len(path) != 3 || path[1] != "actions" || req.Method != http.MethodPost {
+		return 0, nil, newError(http.StatusNotFound, "path '%s' not found", req.URL.Path)
+	}
+
+	var reclamation *resourcecontrollerv2.Reclamation
+	for _, r := range server.reclamations {
+		if *r.ID == path[0] && *r.State == ReclamationStateScheduled {
+			reclamation = r
+		}
+	}
+	if reclamation == nil {
+		return 0, nil, notFound("reclamation", path[0])
+	}
+
+	var body resourcecontrollerv2.RunReclamationActionOptions
+	if err := decodeBody(req, &body); err != nil {
+		return 0, nil, err
+	}
+	requestBy := core.StringPtr(server.options.UserID)
+	if body.RequestBy != nil {
+		requestBy = body.RequestBy
+	}
+
+	now := server.now()
+	inst := server.findInstance(*reclamation.ResourceInstanceID)
+	switch path[2] {
+	case ReclamationActionRestore:
+		reclamation.State = core.StringPtr(ReclamationStateRestoring)
+		if inst != nil {
+			inst.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateActiveConst)
+			inst.ScheduledReclaimAt = nil
+			inst.ScheduledReclaimBy = nil
+			inst.RestoredAt = now
+			inst.RestoredBy = requestBy
+		}
+	case ReclamationActionReclaim:
+		reclamation.State = core.StringPtr(ReclamationStateReclaiming)
+		if inst != nil {
+			inst.State = core.StringPtr(resourcecontrollerv2.ResourceInstanceStateRemovedConst)
+			inst.DeletedAt = now
+			inst.DeletedBy = requestBy
+		}
+	default:
+		return 0, nil, newError(http.StatusBadRequest, "invalid reclamation action '%s'", path[2])
+	}
+	reclamation.UpdatedAt = now
+	reclamation.UpdatedBy = requestBy
+	return http.StatusOK, reclamation, nil
+}
+
+// derefAll returns the values referenced by "items".
+func derefAll[T any](items []*T) []T {
+	values := make([]T, 0, len(items))
+	for _, item := range items {
+		values = append(values, *item)
+	}
+	return values
+}
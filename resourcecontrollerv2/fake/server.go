@@ -0,0 +1,364 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake provides an in-memory implementation of the Resource Controller API,
+// so that code using a real resourcecontrollerv2.ResourceControllerV2 client can be tested offline.
+//
+//	server := fake.NewServer(&fake.Options{AsyncPolls: 2})
+//	defer server.Close()
+//
+//	resourceController, err := server.NewClient()
+//
+// The server implements the lifecycle of resource instances, keys, aliases and bindings, including
+// pagination of list operations with the "start" query parameter, locking, reclamations and
+// asynchronous operations reported through the "last_operation" of resource instances.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// Default values used by NewServer.
+const (
+	DefaultAccountID   = "fake-account-id"
+	DefaultServiceName = "fake-service"
+	DefaultUserID      = "IBMid-fake-user"
+	DefaultPageLimit   = 100
+)
+
+// Options : The options used to create a Server.
+// The zero value of each field selects its default.
+type Options struct {
+	// The account that owns every resource. Defaults to DefaultAccountID.
+	AccountID string
+
+	// The service name used in the CRNs of resource instances. Defaults to DefaultServiceName.
+	ServiceName string
+
+	// The user reported as the creator or updater of every resource. Defaults to DefaultUserID.
+	UserID string
+
+	// The number of times a resource instance must be retrieved before an asynchronous
+	// create, update or delete operation completes. If 0, operations complete immediately.
+	AsyncPolls int
+
+	// If true, deleted resource instances are placed in the "pending_reclamation" state
+	// and a reclamation is created for them, instead of being removed immediately.
+	Reclamation bool
+
+	// The page size used by list operations when no limit is specified. Defaults to DefaultPageLimit.
+	PageLimit int64
+
+	// The function used to obtain the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Server : An in-memory Resource Controller, served over HTTP by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	options Options
+
+	mu           sync.Mutex
+	instances    []*instance
+	keys         []*resourcecontrollerv2.ResourceKey
+	aliases      []*resourcecontrollerv2.ResourceAlias
+	bindings     []*resourcecontrollerv2.ResourceBinding
+	reclamations []*resourcecontrollerv2.Reclamation
+}
+
+// instance is a resource instance along with the state of its asynchronous operation.
+type instance struct {
+	*resourcecontrollerv2.ResourceInstance
+
+	// The number of retrievals remaining before the last operation completes.
+	pendingPolls int
+
+	// The state of the instance once the last operation completes.
+	finalState string
+}
+
+// apiError is the body of an error response.
+type apiError struct {
+	StatusCode int    `json:"status_code"`
+	Code       string `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer(options *Options) *Server {
+	server := NewUnstartedServer(options)
+	server.Start()
+	return server
+}
+
+// NewUnstartedServer returns a new Server but doesn't start it, so that its configuration can be changed first.
+func NewUnstartedServer(options *Options) *Server {
+	server := &Server{}
+	if options != nil {
+		server.options = *options
+	}
+	if server.options.AccountID == "" {
+		server.options.AccountID = DefaultAccountID
+	}
+	if server.options.ServiceName == "" {
+		server.options.ServiceName = DefaultServiceName
+	}
+	if server.options.UserID == "" {
+		server.options.UserID = DefaultUserID
+	}
+	if server.options.PageLimit <= 0 {
+		server.options.PageLimit = DefaultPageLimit
+	}
+	if server.options.Now == nil {
+		server.options.Now = time.Now
+	}
+	server.Server = httptest.NewUnstartedServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// NewClient returns a ResourceControllerV2 client that sends its requests to the server.
+func (server *Server) NewClient() (*resourcecontrollerv2.ResourceControllerV2, error) {
+	return resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// FailLastOperation causes the asynchronous operation in progress on the resource instance identified by "id"
+// (its ID, GUID or CRN) to fail with the specified description.
+func (server *Server) FailLastOperation(id string, description string) error {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	inst := server.findInstance(id)
+	if inst == nil {
+		return fmt.Errorf("resource instance '%s' not found", id)
+	}
+	if !inst.inProgress() {
+		return fmt.Errorf("no operation in progress on resource instance '%s'", id)
+	}
+	inst.finishOperation(resourcecontrollerv2.ResourceInstanceLastOperationStateFailedConst, description, server.now())
+	return nil
+}
+
+// serveHTTP routes a request to the handler of its resource collection.
+func (server *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	// Resource IDs are CRNs, which contain '/' characters, so the path is split before it is unescaped.
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			writeError(res, http.StatusBadRequest, "invalid path segment '%s'", segment)
+			return
+		}
+		segments = append(segments, unescaped)
+	}
+
+	if len(segments) < 2 {
+		writeError(res, http.StatusNotFound, "path '%s' not found", req.URL.Path)
+		return
+	}
+
+	var status int
+	var result interface{}
+	var err error
+	switch segments[0] + "/" + segments[1] {
+	case "v2/resource_instances":
+		status, result, err = server.handleInstances(req, segments[2:])
+	case "v2/resource_keys":
+		status, result, err = server.handleKeys(req, segments[2:])
+	case "v2/resource_aliases":
+		status, result, err = server.handleAliases(req, segments[2:])
+	case "v2/resource_bindings":
+		status, result, err = server.handleBindings(req, segments[2:])
+	case "v1/reclamations":
+		status, result, err = server.handleReclamations(req, segments[2:])
+	default:
+		err = newError(http.StatusNotFound, "path '%s' not found", req.URL.Path)
+	}
+
+	if err != nil {
+		if e, ok := err.(*apiError); ok {
+			writeJSON(res, e.StatusCode, e)
+		} else {
+			writeError(res, http.StatusInternalServerError, "%s", err.Error())
+		}
+		return
+	}
+	if result == nil {
+		res.WriteHeader(status)
+		return
+	}
+	writeJSON(res, status, result)
+}
+
+// listResult is the body of the response of a list operation.
+type listResult[T any] struct {
+	RowsCount int64   `json:"rows_count"`
+	NextURL   *string `json:"next_url"`
+	Resources []T     `json:"resources"`
+}
+
+// paginate returns the page of "items" selected by the "start" and "limit" query parameters of "req".
+// The "start" token is the offset of the first item of the page.
+func paginate[T any](server *Server, req *http.Request, items []T) (result *listResult[T], err error) {
+	query := req.URL.Query()
+
+	limit := server.options.PageLimit
+	if s := query.Get("limit"); s != "" {
+		limit, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || limit <= 0 {
+			return nil, newError(http.StatusBadRequest, "invalid limit '%s'", s)
+		}
+	}
+
+	var start int64
+	if s := query.Get("start"); s != "" {
+		start, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || start < 0 {
+			return nil, newError(http.StatusBadRequest, "invalid start '%s'", s)
+		}
+	}
+
+	if items == nil {
+		items = []T{}
+	}
+	end := min(start+limit, int64(len(items)))
+	start = min(start, end)
+	result = &listResult[T]{
+		RowsCount: end - start,
+		Resources: items[start:end],
+	}
+	if end < int64(len(items)) {
+		query.Set("start", strconv.FormatInt(end, 10))
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		next := req.URL.Path + "?" + query.Encode()
+		result.NextURL = &next
+	}
+	return
+}
+
+// listResponse returns the response to a list operation on "items".
+func listResponse[T any](server *Server, req *http.Request, items []T) (int, interface{}, error) {
+	result, err := paginate(server, req, items)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, result, nil
+}
+
+// filter returns the elements of "items" for which "keep" returns true.
+func filter[T any](items []T, keep func(T) bool) []T {
+	result := []T{}
+	for _, item := range items {
+		if keep(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// matches returns true if the query parameter "name" of "req" is unset or equal to "value".
+func matches(req *http.Request, name string, value *string) bool {
+	expected := req.URL.Query().Get(name)
+	return expected == "" || expected == core.StringNilMapper(value)
+}
+
+// decodeBody decodes the JSON body of "req" into "body".
+func decodeBody(req *http.Request, body interface{}) error {
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		return newError(http.StatusBadRequest, "invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+// requireField returns an error if the request body field "name" is not set.
+func requireField(name string, value *string) error {
+	if value == nil || *value == "" {
+		return newError(http.StatusBadRequest, "the '%s' field is required", name)
+	}
+	return nil
+}
+
+func newError(statusCode int, format string, args ...interface{}) *apiError {
+	return &apiError{
+		StatusCode: statusCode,
+		Code:       strings.ReplaceAll(strings.ToLower(http.StatusText(statusCode)), " ", "_"),
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func notFound(kind string, id string) *apiError {
+	return newError(http.StatusNotFound, "%s '%s' not found", kind, id)
+}
+
+func methodNotAllowed(req *http.Request) *apiError {
+	return newError(http.StatusMethodNotAllowed, "method '%s' is not allowed on '%s'", req.Method, req.URL.Path)
+}
+
+func writeError(res http.ResponseWriter, statusCode int, format string, args ...interface{}) {
+	writeJSON(res, statusCode, newError(statusCode, format, args...))
+}
+
+func writeJSON(res http.ResponseWriter, statusCode int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+// now returns the current time in the form used by the models.
+func (server *Server) now() *strfmt.DateTime {
+	now := strfmt.DateTime(server.options.Now().UTC())
+	return &now
+}
+
+// newCRN returns a CRN for a resource of the server's service.
+func (server *Server) newCRN(location string, serviceInstance string, resourceType string, resource string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s:%s:%s",
+		server.options.ServiceName, location, server.options.AccountID, serviceInstance, resourceType, resource)
+}
+
+// newGUID returns a new random GUID.
+func newGUID() string {
+	return uuid.New().String()
+}
+
+// hasID returns true if "id" is the ID, GUID or CRN of a resource.
+func hasID(id string, resourceID *string, guid *string, crn *string) bool {
+	return id != "" && slices.Contains([]string{core.StringNilMapper(resourceID), core.StringNilMapper(guid), core.StringNilMapper(crn)}, id)
+}
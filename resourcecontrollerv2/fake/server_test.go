@@ -0,0 +1,299 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fake_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2/fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fake Resource Controller`, func() {
+	var server *fake.Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+
+	waitOptions := &resourcecontrollerv2.WaitForResourceInstanceStateOptions{
+		Interval: time.Millisecond,
+		Timeout:  5 * time.Second,
+	}
+
+	startServer := func(options *fake.Options) {
+		server = fake.NewServer(options)
+		var err error
+		resourceControllerService, err = server.NewClient()
+		Expect(err).To(BeNil())
+	}
+
+	createInstance := func(name string) *resourcecontrollerv2.ResourceInstance {
+		createResourceInstanceOptions := resourceControllerService.NewCreateResourceInstanceOptions(name, "us-south", "resource-group-id", "plan-id")
+		createResourceInstanceOptions.SetParameters(map[string]interface{}{"size": "small"})
+		result, response, err := resourceControllerService.CreateResourceInstance(createResourceInstanceOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(BeElementOf(201, 202))
+		return result
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`Resource instances`, func() {
+		BeforeEach(func() {
+			startServer(&fake.Options{AsyncPolls: 2, AccountID: "account-id", ServiceName: "my-service"})
+		})
+		It(`Create, update and delete a resource instance asynchronously`, func() {
+			instance := createInstance("my-instance")
+			Expect(*instance.State).To(Equal("provisioning"))
+			Expect(*instance.LastOperation.State).To(Equal("in progress"))
+			Expect(*instance.CRN).To(HavePrefix("crn:v1:bluemix:public:my-service:us-south:a/account-id:" + *instance.GUID))
+			Expect(*instance.ID).To(Equal(*instance.CRN))
+
+			instance, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("active"))
+			Expect(*instance.LastOperation.Type).To(Equal("create"))
+			Expect(*instance.LastOperation.State).To(Equal("succeeded"))
+
+			updateOptions := resourceControllerService.NewUpdateResourceInstanceOptions(*instance.GUID)
+			updateOptions.SetName("renamed").SetResourcePlanID("other-plan-id")
+			instance, response, err := resourceControllerService.UpdateResourceInstance(updateOptions)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(*instance.Name).To(Equal("renamed"))
+			Expect(instance.PlanHistory).To(HaveLen(2))
+
+			// A second update is rejected while the first one is in progress.
+			_, response, err = resourceControllerService.UpdateResourceInstance(updateOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(422))
+
+			instance, err = resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*instance.LastOperation.Type).To(Equal("update"))
+
+			response, err = resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+
+			instance, err = resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"removed"}, waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("removed"))
+			Expect(instance.DeletedAt).ToNot(BeNil())
+		})
+		It(`Report a failed operation`, func() {
+			instance := createInstance("my-instance")
+			Expect(server.FailLastOperation(*instance.GUID, "Quota exceeded")).To(Succeed())
+
+			_, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("Quota exceeded"))
+
+			instance, _, err = resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("failed"))
+		})
+		It(`Cancel the last operation`, func() {
+			instance := createInstance("my-instance")
+
+			instance, _, err := resourceControllerService.CancelLastopResourceInstance(resourceControllerService.NewCancelLastopResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.LastOperation.State).To(Equal("failed"))
+
+			_, response, err := resourceControllerService.CancelLastopResourceInstance(resourceControllerService.NewCancelLastopResourceInstanceOptions(*instance.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(422))
+		})
+		It(`Lock and unlock a resource instance`, func() {
+			instance := createInstance("my-instance")
+			_, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+			Expect(err).To(BeNil())
+
+			instance, _, err = resourceControllerService.LockResourceInstance(resourceControllerService.NewLockResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.Locked).To(BeTrue())
+
+			response, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(422))
+
+			instance, _, err = resourceControllerService.UnlockResourceInstance(resourceControllerService.NewUnlockResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.Locked).To(BeFalse())
+		})
+		It(`Return 404 for an unknown resource instance`, func() {
+			_, response, err := resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions("unknown"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		})
+	})
+
+	Describe(`Pagination`, func() {
+		BeforeEach(func() {
+			startServer(&fake.Options{PageLimit: 2})
+			for _, name := range []string{"a", "b", "c", "d", "e"} {
+				createInstance(name)
+			}
+		})
+		It(`List resource instances with the pager`, func() {
+			pager, err := resourceControllerService.NewResourceInstancesPager(&resourcecontrollerv2.ListResourceInstancesOptions{})
+			Expect(err).To(BeNil())
+
+			var pages int
+			var names []string
+			for pager.HasNext() {
+				page, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				pages++
+				for _, instance := range page {
+					names = append(names, *instance.Name)
+				}
+			}
+			Expect(pages).To(Equal(3))
+			Expect(names).To(Equal([]string{"a", "b", "c", "d", "e"}))
+		})
+		It(`List resource instances with filters`, func() {
+			result, _, err := resourceControllerService.ListResourceInstances(&resourcecontrollerv2.ListResourceInstancesOptions{
+				Name:  core.StringPtr("c"),
+				Limit: core.Int64Ptr(10),
+			})
+			Expect(err).To(BeNil())
+			Expect(result.Resources).To(HaveLen(1))
+			Expect(result.NextURL).To(BeNil())
+
+			result, _, err = resourceControllerService.ListResourceInstances(&resourcecontrollerv2.ListResourceInstancesOptions{
+				State: core.StringPtr("removed"),
+			})
+			Expect(err).To(BeNil())
+			Expect(result.Resources).To(BeEmpty())
+		})
+	})
+
+	Describe(`Resource keys, aliases and bindings`, func() {
+		BeforeEach(func() {
+			startServer(nil)
+		})
+		It(`Manage the dependent resources of an instance`, func() {
+			instance := createInstance("my-instance")
+
+			key, _, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("my-key", *instance.GUID).SetRole("Writer"))
+			Expect(err).To(BeNil())
+			Expect(*key.SourceCRN).To(Equal(*instance.CRN))
+			Expect(*key.Credentials.IamRoleCRN).To(Equal("crn:v1:bluemix:public:iam::::serviceRole:Writer"))
+			Expect(key.Credentials.Apikey).ToNot(BeNil())
+
+			key, _, err = resourceControllerService.UpdateResourceKey(resourceControllerService.NewUpdateResourceKeyOptions(*key.GUID, "renamed-key"))
+			Expect(err).To(BeNil())
+			Expect(*key.Name).To(Equal("renamed-key"))
+
+			alias, _, err := resourceControllerService.CreateResourceAlias(resourceControllerService.NewCreateResourceAliasOptions("my-alias", *instance.ID, "crn:v1:bluemix:public:cf:us-south:o/org-id::cf-space:space-id"))
+			Expect(err).To(BeNil())
+			Expect(*alias.ResourceInstanceID).To(Equal(*instance.ID))
+
+			binding, _, err := resourceControllerService.CreateResourceBinding(resourceControllerService.NewCreateResourceBindingOptions(*alias.ID, "crn:v1:bluemix:public:cf:us-south:s/space-id::cf-application:app-id"))
+			Expect(err).To(BeNil())
+			Expect(*binding.SourceCRN).To(Equal(*alias.CRN))
+
+			keys, _, err := resourceControllerService.ListResourceKeysForInstance(resourceControllerService.NewListResourceKeysForInstanceOptions(*instance.GUID))
+			Expect(err).To(BeNil())
+			Expect(keys.Resources).To(HaveLen(1))
+
+			aliases, _, err := resourceControllerService.ListResourceAliasesForInstance(resourceControllerService.NewListResourceAliasesForInstanceOptions(*instance.GUID))
+			Expect(err).To(BeNil())
+			Expect(aliases.Resources).To(HaveLen(1))
+
+			bindings, _, err := resourceControllerService.ListResourceBindingsForAlias(resourceControllerService.NewListResourceBindingsForAliasOptions(*alias.GUID))
+			Expect(err).To(BeNil())
+			Expect(bindings.Resources).To(HaveLen(1))
+
+			// The instance cannot be deleted while it has dependent resources, unless the deletion is recursive.
+			response, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+
+			response, err = resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID).SetRecursive(true))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(204))
+
+			allKeys, _, err := resourceControllerService.ListResourceKeys(&resourcecontrollerv2.ListResourceKeysOptions{})
+			Expect(err).To(BeNil())
+			Expect(allKeys.Resources).To(BeEmpty())
+
+			allBindings, _, err := resourceControllerService.ListResourceBindings(&resourcecontrollerv2.ListResourceBindingsOptions{})
+			Expect(err).To(BeNil())
+			Expect(allBindings.Resources).To(BeEmpty())
+
+			key, _, err = resourceControllerService.GetResourceKey(resourceControllerService.NewGetResourceKeyOptions(*key.ID))
+			Expect(err).To(BeNil())
+			Expect(*key.State).To(Equal("removed"))
+		})
+		It(`Reject a key for an unknown source`, func() {
+			_, response, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("my-key", "unknown"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+		})
+	})
+
+	Describe(`Reclamations`, func() {
+		BeforeEach(func() {
+			startServer(&fake.Options{Reclamation: true})
+		})
+		It(`Restore and reclaim deleted instances`, func() {
+			restored := createInstance("restored")
+			reclaimed := createInstance("reclaimed")
+			for _, instance := range []*resourcecontrollerv2.ResourceInstance{restored, reclaimed} {
+				response, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+				Expect(err).To(BeNil())
+				Expect(response.StatusCode).To(Equal(202))
+			}
+
+			reclamations, _, err := resourceControllerService.ListReclamations(&resourcecontrollerv2.ListReclamationsOptions{})
+			Expect(err).To(BeNil())
+			Expect(reclamations.Resources).To(HaveLen(2))
+
+			instance, _, err := resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*restored.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("pending_reclamation"))
+
+			for _, reclamation := range reclamations.Resources {
+				action := "reclaim"
+				if *reclamation.ResourceInstanceID == *restored.GUID {
+					action = "restore"
+				}
+				_, _, err := resourceControllerService.RunReclamationAction(resourceControllerService.NewRunReclamationActionOptions(*reclamation.ID, action))
+				Expect(err).To(BeNil())
+			}
+
+			instance, _, err = resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*restored.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("active"))
+			Expect(instance.RestoredAt).ToNot(BeNil())
+
+			instance, _, err = resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*reclaimed.ID))
+			Expect(err).To(BeNil())
+			Expect(*instance.State).To(Equal("removed"))
+
+			reclamations, _, err = resourceControllerService.ListReclamations(&resourcecontrollerv2.ListReclamationsOptions{})
+			Expect(err).To(BeNil())
+			Expect(reclamations.Resources).To(BeEmpty())
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -5733,6 +5734,13 @@ func (pager *ResourceInstancesPager) GetAll() (allItems []ResourceInstance, err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceInstancesPager) Items(ctx context.Context) iter.Seq2[ResourceInstance, error] {
+	return common.Items[ResourceInstance](ctx, pager)
+}
+
 // ResourceAliasesForInstancePager can be used to simplify the use of the "ListResourceAliasesForInstance" method.
 type ResourceAliasesForInstancePager struct {
 	hasNext     bool
@@ -5825,6 +5833,13 @@ func (pager *ResourceAliasesForInstancePager) GetAll() (allItems []ResourceAlias
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceAliasesForInstancePager) Items(ctx context.Context) iter.Seq2[ResourceAlias, error] {
+	return common.Items[ResourceAlias](ctx, pager)
+}
+
 // ResourceKeysForInstancePager can be used to simplify the use of the "ListResourceKeysForInstance" method.
 type ResourceKeysForInstancePager struct {
 	hasNext     bool
@@ -5917,6 +5932,13 @@ func (pager *ResourceKeysForInstancePager) GetAll() (allItems []ResourceKey, err
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceKeysForInstancePager) Items(ctx context.Context) iter.Seq2[ResourceKey, error] {
+	return common.Items[ResourceKey](ctx, pager)
+}
+
 // ResourceKeysPager can be used to simplify the use of the "ListResourceKeys" method.
 type ResourceKeysPager struct {
 	hasNext     bool
@@ -6009,6 +6031,13 @@ func (pager *ResourceKeysPager) GetAll() (allItems []ResourceKey, err error) {
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceKeysPager) Items(ctx context.Context) iter.Seq2[ResourceKey, error] {
+	return common.Items[ResourceKey](ctx, pager)
+}
+
 // ResourceBindingsPager can be used to simplify the use of the "ListResourceBindings" method.
 type ResourceBindingsPager struct {
 	hasNext     bool
@@ -6101,6 +6130,13 @@ func (pager *ResourceBindingsPager) GetAll() (allItems []ResourceBinding, err er
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceBindingsPager) Items(ctx context.Context) iter.Seq2[ResourceBinding, error] {
+	return common.Items[ResourceBinding](ctx, pager)
+}
+
 // ResourceAliasesPager can be used to simplify the use of the "ListResourceAliases" method.
 type ResourceAliasesPager struct {
 	hasNext     bool
@@ -6193,6 +6229,13 @@ func (pager *ResourceAliasesPager) GetAll() (allItems []ResourceAlias, err error
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceAliasesPager) Items(ctx context.Context) iter.Seq2[ResourceAlias, error] {
+	return common.Items[ResourceAlias](ctx, pager)
+}
+
 // ResourceBindingsForAliasPager can be used to simplify the use of the "ListResourceBindingsForAlias" method.
 type ResourceBindingsForAliasPager struct {
 	hasNext     bool
@@ -6284,3 +6327,10 @@ func (pager *ResourceBindingsForAliasPager) GetAll() (allItems []ResourceBinding
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *ResourceBindingsForAliasPager) Items(ctx context.Context) iter.Seq2[ResourceBinding, error] {
+	return common.Items[ResourceBinding](ctx, pager)
+}
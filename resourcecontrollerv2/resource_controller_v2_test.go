@@ -571,6 +571,39 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceInstancesPager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceInstancesOptionsModel := &resourcecontrollerv2.ListResourceInstancesOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					ResourcePlanID:  core.StringPtr("testString"),
+					Type:            core.StringPtr("testString"),
+					SubType:         core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					State:           core.StringPtr("active"),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceInstancesPager(listResourceInstancesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceInstance
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) - Operation response error`, func() {
@@ -1728,6 +1761,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesForInstancePager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesForInstanceOptionsModel := &resourcecontrollerv2.ListResourceAliasesForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesForInstancePager(listResourceAliasesForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListResourceKeysForInstance(listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) - Operation response error`, func() {
@@ -2053,6 +2110,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysForInstancePager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysForInstanceOptionsModel := &resourcecontrollerv2.ListResourceKeysForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysForInstancePager(listResourceKeysForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`LockResourceInstance(lockResourceInstanceOptions *LockResourceInstanceOptions) - Operation response error`, func() {
@@ -3060,6 +3141,35 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysPager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysOptionsModel := &resourcecontrollerv2.ListResourceKeysOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysPager(listResourceKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceKey(createResourceKeyOptions *CreateResourceKeyOptions) - Operation response error`, func() {
@@ -4254,6 +4364,36 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsPager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsOptionsModel := &resourcecontrollerv2.ListResourceBindingsOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					RegionBindingID: core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsPager(listResourceBindingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceBinding(createResourceBindingOptions *CreateResourceBindingOptions) - Operation response error`, func() {
@@ -5463,6 +5603,37 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesPager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesOptionsModel := &resourcecontrollerv2.ListResourceAliasesOptions{
+					GUID:               core.StringPtr("testString"),
+					Name:               core.StringPtr("testString"),
+					ResourceInstanceID: core.StringPtr("testString"),
+					RegionInstanceID:   core.StringPtr("testString"),
+					ResourceID:         core.StringPtr("testString"),
+					ResourceGroupID:    core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					UpdatedFrom:        core.StringPtr("2021-01-01"),
+					UpdatedTo:          core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesPager(listResourceAliasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceAlias(createResourceAliasOptions *CreateResourceAliasOptions) - Operation response error`, func() {
@@ -6574,6 +6745,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsForAliasPager.Items successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsForAliasOptionsModel := &resourcecontrollerv2.ListResourceBindingsForAliasOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsForAliasPager(listResourceBindingsForAliasOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListReclamations(listReclamationsOptions *ListReclamationsOptions) - Operation response error`, func() {
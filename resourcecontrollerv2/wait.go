@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// WaitForResourceInstanceStateOptions : The WaitForResourceInstanceState options.
+// The zero value of each field selects its default.
+type WaitForResourceInstanceStateOptions struct {
+	// The delay before the second poll. Defaults to common.DefaultWaitInterval.
+	Interval time.Duration
+
+	// The upper bound of the delay between two polls. Defaults to common.DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// The factor applied to the delay after each poll. Defaults to common.DefaultWaitMultiplier;
+	// use a value of 1 to poll at a fixed interval.
+	Multiplier float64
+
+	// The maximum amount of time to wait. If not set, the wait is bounded only by the Context.
+	Timeout time.Duration
+
+	// A failed last operation is ignored once the instance is in a target state if the instance was last updated
+	// before this time, since the failure then predates the operation being waited for. Defaults to the start of
+	// the wait; set it to the time of the request that started the operation to allow for a late first poll.
+	Since time.Time
+
+	// The instance states in which the wait fails immediately, unless they are also target states.
+	// Defaults to ["failed"].
+	FailureStates []string
+
+	// An optional callback invoked with the instance retrieved by each poll.
+	// The instance is nil if the instance could not be found.
+	Progress func(instance *ResourceInstance, attempt int)
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// WaitForResourceInstanceState polls the resource instance identified by "id" until its state is one of "targetStates"
+// and its last operation (if any) is no longer in progress.
+//
+// The instance is retrieved with GetResourceInstance, first immediately and then with an exponentially increasing
+// delay. The wait fails if the instance reaches one of the failure states, if the last operation on the instance
+// failed (unless the instance is in a target state and the failure predates opts.Since), or if the Context is
+// cancelled or the timeout expires. If "targetStates" contains "removed",
+// an instance that can no longer be found is considered to have reached that state and a nil instance is returned.
+func (resourceController *ResourceControllerV2) WaitForResourceInstanceState(ctx context.Context, id string, targetStates []string, opts *WaitForResourceInstanceStateOptions) (result *ResourceInstance, err error) {
+	if id == "" {
+		err = core.SDKErrorf(nil, "the 'id' parameter must be specified", "missing-id", common.GetComponentInfo())
+		return
+	}
+	if len(targetStates) == 0 {
+		err = core.SDKErrorf(nil, "at least one target state must be specified", "missing-target-states", common.GetComponentInfo())
+		return
+	}
+	if opts == nil {
+		opts = &WaitForResourceInstanceStateOptions{}
+	}
+	since := opts.Since
+	if since.IsZero() {
+		since = time.Now()
+	}
+
+	backoff := common.NewBackoff(opts.Interval, opts.MaxInterval, opts.Multiplier)
+	failureStates := opts.FailureStates
+	if failureStates == nil {
+		failureStates = []string{ResourceInstanceStateFailedConst}
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	getOptions := resourceController.NewGetResourceInstanceOptions(id)
+	getOptions.SetHeaders(opts.Headers)
+
+	for attempt := 1; ; attempt++ {
+		var response *core.DetailedResponse
+		result, response, err = resourceController.GetResourceInstanceWithContext(ctx, getOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound && slices.Contains(targetStates, ResourceInstanceStateRemovedConst) {
+				if opts.Progress != nil {
+					opts.Progress(nil, attempt)
+				}
+				return nil, nil
+			}
+			if ctx.Err() != nil {
+				err = common.WaitInterrupted(ctx, fmt.Sprintf("resource instance '%s'", id))
+				return
+			}
+			err = core.RepurposeSDKProblem(err, "wait-get-error")
+			return
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(result, attempt)
+		}
+
+		// The failure of an operation that predates the wait may still be reported once the instance is in a target
+		// state, but a failed operation that leaves the instance in a target state (e.g. an update) must be reported.
+		state := core.StringNilMapper(result.State)
+		inTargetState := slices.Contains(targetStates, state)
+		if lastOperationFailed(result) && (!inTargetState || !updatedBefore(result, since)) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("last operation on resource instance '%s' failed%s", id, lastOperationDetails(result)), "wait-operation-failed", common.GetComponentInfo())
+			return
+		}
+		if inTargetState && !lastOperationInProgress(result) {
+			return
+		}
+		if slices.Contains(failureStates, state) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("resource instance '%s' reached state '%s'%s", id, state, lastOperationDetails(result)), "wait-failed-state", common.GetComponentInfo())
+			return
+		}
+
+		err = common.SleepContext(ctx, backoff.Next(), fmt.Sprintf("resource instance '%s' in state '%s'", id, state))
+		if err != nil {
+			return
+		}
+	}
+}
+
+// lastOperationInProgress returns true if the last operation on "instance" has not completed yet.
+func lastOperationInProgress(instance *ResourceInstance) bool {
+	return instance.LastOperation != nil && core.StringNilMapper(instance.LastOperation.State) == ResourceInstanceLastOperationStateInProgressConst
+}
+
+// lastOperationFailed returns true if the last operation on "instance" failed.
+func lastOperationFailed(instance *ResourceInstance) bool {
+	return instance.LastOperation != nil && core.StringNilMapper(instance.LastOperation.State) == ResourceInstanceLastOperationStateFailedConst
+}
+
+// updatedBefore returns true if "instance" was last updated before "t". It returns false if the time of the last
+// update is unknown.
+func updatedBefore(instance *ResourceInstance, t time.Time) bool {
+	return instance.UpdatedAt != nil && time.Time(*instance.UpdatedAt).Before(t)
+}
+
+// lastOperationDetails returns a description of the last operation on "instance", suitable for an error message.
+func lastOperationDetails(instance *ResourceInstance) string {
+	if instance.LastOperation == nil {
+		return ""
+	}
+	details := fmt.Sprintf(" (last operation: %s %s", core.StringNilMapper(instance.LastOperation.Type), core.StringNilMapper(instance.LastOperation.State))
+	if instance.LastOperation.Description != nil && *instance.LastOperation.Description != "" {
+		details += ": " + *instance.LastOperation.Description
+	}
+	return details + ")"
+}
@@ -0,0 +1,216 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForResourceInstanceState`, func() {
+	var testServer *httptest.Server
+	var responses []string
+	var requestNumber int
+
+	getResourceInstancePath := "/v2/resource_instances/testString"
+	fastOptions := &resourcecontrollerv2.WaitForResourceInstanceStateOptions{
+		Interval:    time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+		Multiplier:  2,
+	}
+
+	instanceJSON := func(state string, lastOperationState string) string {
+		return fmt.Sprintf(`{"id":"testString","state":"%s","last_operation":{"type":"create","state":"%s","async":true,"description":"Description","cancelable":false,"poll":true}}`, state, lastOperationState)
+	}
+
+	newService := func() *resourcecontrollerv2.ResourceControllerV2 {
+		resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(resourceControllerService).ToNot(BeNil())
+		return resourceControllerService
+	}
+
+	BeforeEach(func() {
+		requestNumber = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal(getResourceInstancePath))
+			Expect(req.Method).To(Equal("GET"))
+
+			// Set mock response
+			response := responses[len(responses)-1]
+			if requestNumber < len(responses) {
+				response = responses[requestNumber]
+			}
+			requestNumber++
+			if response == "" {
+				res.WriteHeader(404)
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, response)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Wait for provisioning to complete`, func() {
+		responses = []string{
+			instanceJSON("provisioning", "in progress"),
+			instanceJSON("provisioning", "in progress"),
+			instanceJSON("active", "succeeded"),
+		}
+		var attempts []int
+		options := *fastOptions
+		options.Progress = func(instance *resourcecontrollerv2.ResourceInstance, attempt int) {
+			Expect(instance).ToNot(BeNil())
+			attempts = append(attempts, attempt)
+		}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, &options)
+		Expect(err).To(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(*result.State).To(Equal("active"))
+		Expect(attempts).To(Equal([]int{1, 2, 3}))
+	})
+	It(`Wait for an update to complete`, func() {
+		responses = []string{
+			instanceJSON("active", "in progress"),
+			instanceJSON("active", "succeeded"),
+		}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.LastOperation.State).To(Equal("succeeded"))
+		Expect(requestNumber).To(Equal(2))
+	})
+	It(`Wait for removal of an instance that is no longer found`, func() {
+		responses = []string{
+			instanceJSON("active", "in progress"),
+			"",
+		}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"removed"}, fastOptions)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It(`Fail when the instance cannot be found`, func() {
+		responses = []string{""}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It(`Fail when the last operation failed`, func() {
+		responses = []string{
+			instanceJSON("provisioning", "in progress"),
+			instanceJSON("provisioning", "failed"),
+		}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed"))
+		Expect(err.Error()).To(ContainSubstring("Description"))
+		Expect(result).ToNot(BeNil())
+	})
+	It(`Fail when an operation fails on an instance that stays in the target state`, func() {
+		responses = []string{
+			instanceJSON("active", "in progress"),
+			instanceJSON("active", "failed"),
+		}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("last operation on resource instance 'testString' failed"))
+		Expect(*result.State).To(Equal("active"))
+	})
+	It(`Ignore an operation that failed before the wait started`, func() {
+		staleJSON := strings.Replace(instanceJSON("active", "failed"), `"state"`, `"updated_at":"2020-01-01T00:00:00.000Z","state"`, 1)
+		responses = []string{staleJSON}
+
+		result, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("active"))
+
+		responses = []string{strings.Replace(staleJSON, "2020-01-01T00:00:00.000Z", time.Now().Add(time.Hour).UTC().Format(time.RFC3339), 1)}
+		requestNumber = 0
+		_, err = newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Fail when the instance reaches a failure state`, func() {
+		responses = []string{
+			instanceJSON("failed", "succeeded"),
+		}
+
+		_, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, fastOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("reached state 'failed'"))
+	})
+	It(`Fail when the timeout expires`, func() {
+		responses = []string{
+			instanceJSON("provisioning", "in progress"),
+		}
+		options := *fastOptions
+		options.Timeout = 50 * time.Millisecond
+
+		_, err := newService().WaitForResourceInstanceState(context.Background(), "testString", []string{"active"}, &options)
+		Expect(err).ToNot(BeNil())
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(err.Error()).To(HavePrefix("timed out waiting for resource instance 'testString'"))
+		Expect(requestNumber).To(BeNumerically(">", 1))
+	})
+	It(`Fail when the context is cancelled`, func() {
+		responses = []string{
+			instanceJSON("provisioning", "in progress"),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		options := *fastOptions
+		options.Progress = func(instance *resourcecontrollerv2.ResourceInstance, attempt int) {
+			cancel()
+		}
+
+		_, err := newService().WaitForResourceInstanceState(ctx, "testString", []string{"active"}, &options)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(err.Error()).To(HavePrefix("cancelled while waiting for resource instance 'testString'"))
+	})
+	It(`Invoke WaitForResourceInstanceState with invalid parameters`, func() {
+		responses = []string{instanceJSON("active", "succeeded")}
+		resourceControllerService := newService()
+
+		_, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), "", []string{"active"}, nil)
+		Expect(err).ToNot(BeNil())
+		_, err = resourceControllerService.WaitForResourceInstanceState(context.Background(), "testString", nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(requestNumber).To(Equal(0))
+	})
+})
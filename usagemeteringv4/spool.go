@@ -0,0 +1,510 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultSpoolMaxSegmentBytes is the size of a spool segment above which the spool is compacted into a new segment,
+// when UsageSpoolOptions.MaxSegmentBytes is not set.
+const DefaultSpoolMaxSegmentBytes = 4 * 1024 * 1024
+
+// The name of the spool segment files, with their sequence number.
+const (
+	spoolSegmentPrefix = "segment-"
+	spoolSegmentSuffix = ".log"
+)
+
+// The operations recorded in a spool segment.
+const (
+	spoolOpAppend = "append"
+	spoolOpAck    = "ack"
+)
+
+// UsageSpoolOptions : The UsageSpool options.
+type UsageSpoolOptions struct {
+	// The size of a segment above which the spool is compacted. Defaults to DefaultSpoolMaxSegmentBytes.
+	MaxSegmentBytes int64
+
+	// The options of the UsageSubmitter used to send the spooled records.
+	SubmitterOptions *UsageSubmitterOptions
+}
+
+// UsageSpool : A write-ahead spool of usage records, stored in a local directory, that ensures that usage records
+// are reported even if the process crashes or loses connectivity before they are accepted by the service.
+//
+// Records are appended to the current segment file of the directory, and synced to disk, before they are sent.
+// The records accepted or permanently rejected by the service are then acknowledged in the segment, while the
+// records that failed with a retryable status remain pending until they are sent again by Replay, typically when the
+// process restarts or the connectivity is restored. Acknowledged records are removed from the directory when the
+// spool is compacted, which happens when all records are acknowledged or when the current segment grows beyond
+// MaxSegmentBytes.
+//
+// The methods of a UsageSpool can be called concurrently, but are serialized. A directory must be used by
+// a single UsageSpool at a time.
+type UsageSpool struct {
+	service         *UsageMeteringV4
+	dir             string
+	maxSegmentBytes int64
+	submitterOpts   *UsageSubmitterOptions
+
+	mu           sync.Mutex
+	segment      *os.File
+	segmentNum   int
+	segmentBytes int64
+	nextSeq      int64
+	pending      map[int64]*spoolEntry
+}
+
+// spoolEntry : A line of a spool segment.
+type spoolEntry struct {
+	Op         string                 `json:"op"`
+	Seq        int64                  `json:"seq"`
+	ResourceID string                 `json:"resource_id,omitempty"`
+	Record     *ResourceInstanceUsage `json:"record,omitempty"`
+}
+
+// SpooledUsage : A usage record that was not acknowledged yet.
+type SpooledUsage struct {
+	// The resource for which the usage is submitted.
+	ResourceID string
+
+	// The usage record.
+	Record ResourceInstanceUsage
+}
+
+// OpenUsageSpool opens the spool stored in the directory "dir", which is created if needed.
+// The records of the spool that were not acknowledged are loaded, to be sent by Replay.
+func (usageMetering *UsageMeteringV4) OpenUsageSpool(dir string, opts *UsageSpoolOptions) (spool *UsageSpool, err error) {
+	if opts == nil {
+		opts = &UsageSpoolOptions{}
+	}
+	spool = &UsageSpool{
+		service:         usageMetering,
+		dir:             dir,
+		maxSegmentBytes: opts.MaxSegmentBytes,
+		submitterOpts:   opts.SubmitterOptions,
+		nextSeq:         1,
+		pending:         make(map[int64]*spoolEntry),
+	}
+	if spool.maxSegmentBytes <= 0 {
+		spool.maxSegmentBytes = DefaultSpoolMaxSegmentBytes
+	}
+
+	err = os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the usage spool directory: %w", err)
+	}
+	segments, err := spool.segmentNumbers()
+	if err != nil {
+		return nil, err
+	}
+	for i, num := range segments {
+		err = spool.load(num, i == len(segments)-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(segments) > 0 {
+		spool.segmentNum = segments[len(segments)-1]
+		spool.segment, err = os.OpenFile(spool.segmentPath(spool.segmentNum), os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("error opening the usage spool segment: %w", err)
+		}
+		info, statErr := spool.segment.Stat()
+		if statErr != nil {
+			spool.segment.Close()
+			return nil, fmt.Errorf("error opening the usage spool segment: %w", statErr)
+		}
+		spool.segmentBytes = info.Size()
+		return spool, nil
+	}
+	err = spool.createSegment(1)
+	if err != nil {
+		return nil, err
+	}
+	return spool, nil
+}
+
+// ReportResourceUsage persists the usage records of "options" in the spool, then submits them with
+// a UsageSubmitter and acknowledges those that were accepted or permanently rejected. The records that
+// failed with a retryable status remain in the spool, to be sent by Replay.
+//
+// An error is returned if the records are invalid or cannot be persisted, in which case none of them is
+// submitted, or if the Context is cancelled or expires.
+func (spool *UsageSpool) ReportResourceUsage(ctx context.Context, options *ReportResourceUsageOptions) (report *UsageSubmissionReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		return
+	}
+	for i := range options.ResourceUsage {
+		err = ValidateResourceInstanceUsage(&options.ResourceUsage[i])
+		if err != nil {
+			err = fmt.Errorf("invalid usage record %d: %w", i, err)
+			return
+		}
+	}
+
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	entries, err := spool.append(*options.ResourceID, options.ResourceUsage)
+	if err != nil {
+		return
+	}
+	return spool.submit(ctx, *options.ResourceID, entries, options.Headers)
+}
+
+// Replay submits all the records of the spool that were not acknowledged, grouped by resource, and acknowledges
+// those that were accepted or permanently rejected. The report merges the outcomes for all resources.
+func (spool *UsageSpool) Replay(ctx context.Context) (report *UsageSubmissionReport, err error) {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	byResource := make(map[string][]*spoolEntry)
+	var resourceIDs []string
+	for _, entry := range spool.pendingEntries() {
+		if byResource[entry.ResourceID] == nil {
+			resourceIDs = append(resourceIDs, entry.ResourceID)
+		}
+		byResource[entry.ResourceID] = append(byResource[entry.ResourceID], entry)
+	}
+
+	report = &UsageSubmissionReport{}
+	for _, resourceID := range resourceIDs {
+		var resourceReport *UsageSubmissionReport
+		resourceReport, err = spool.submit(ctx, resourceID, byResource[resourceID], nil)
+		if resourceReport != nil {
+			report.Accepted = append(report.Accepted, resourceReport.Accepted...)
+			report.Failed = append(report.Failed, resourceReport.Failed...)
+			report.Invalid = append(report.Invalid, resourceReport.Invalid...)
+			report.Duplicates = append(report.Duplicates, resourceReport.Duplicates...)
+			report.Requests += resourceReport.Requests
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Pending returns the records of the spool that were not acknowledged, in the order in which they were spooled.
+func (spool *UsageSpool) Pending() []SpooledUsage {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	var pending []SpooledUsage
+	for _, entry := range spool.pendingEntries() {
+		pending = append(pending, SpooledUsage{ResourceID: entry.ResourceID, Record: *entry.Record})
+	}
+	return pending
+}
+
+// Compact rewrites the records that were not acknowledged into a new segment and removes the previous segments.
+func (spool *UsageSpool) Compact() error {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+	return spool.compact()
+}
+
+// Close closes the current segment of the spool. The spool must not be used afterwards.
+func (spool *UsageSpool) Close() error {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+	if spool.segment == nil {
+		return nil
+	}
+	err := spool.segment.Close()
+	spool.segment = nil
+	return err
+}
+
+// submit sends the records of "entries" with a UsageSubmitter and acknowledges those that do not need to be sent again.
+func (spool *UsageSpool) submit(ctx context.Context, resourceID string, entries []*spoolEntry, headers map[string]string) (report *UsageSubmissionReport, err error) {
+	submitterOpts := UsageSubmitterOptions{}
+	if spool.submitterOpts != nil {
+		submitterOpts = *spool.submitterOpts
+	}
+	if headers != nil {
+		submitterOpts.Headers = headers
+	}
+
+	records := make([]ResourceInstanceUsage, len(entries))
+	seqs := make(map[usageRecordKey][]int64)
+	for i, entry := range entries {
+		records[i] = *entry.Record
+		key := recordKey(entry.Record)
+		seqs[key] = append(seqs[key], entry.Seq)
+	}
+
+	report, err = spool.service.NewUsageSubmitter(resourceID, &submitterOpts).Submit(ctx, records)
+
+	// Duplicates are acknowledged along with the first record with the same key.
+	var acks []int64
+	for _, result := range report.Accepted {
+		acks = append(acks, seqs[recordKey(&result.Record)]...)
+	}
+	for _, result := range report.Failed {
+		if !result.retryable && result.Status != 0 {
+			acks = append(acks, seqs[recordKey(&result.Record)]...)
+		}
+	}
+	ackErr := spool.ack(acks)
+	if err == nil {
+		err = ackErr
+	}
+	return
+}
+
+// recordKey returns the deduplication key of a valid usage record.
+func recordKey(record *ResourceInstanceUsage) usageRecordKey {
+	return usageRecordKey{*record.ResourceInstanceID, *record.PlanID, *record.Start, *record.End}
+}
+
+// pendingEntries returns the entries that were not acknowledged, ordered by sequence number.
+func (spool *UsageSpool) pendingEntries() []*spoolEntry {
+	entries := make([]*spoolEntry, 0, len(spool.pending))
+	for _, entry := range spool.pending {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b *spoolEntry) int {
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+	return entries
+}
+
+// append persists the records of a resource and returns their entries.
+func (spool *UsageSpool) append(resourceID string, records []ResourceInstanceUsage) ([]*spoolEntry, error) {
+	if spool.segment == nil {
+		return nil, errors.New("the usage spool is closed")
+	}
+	entries := make([]*spoolEntry, len(records))
+	var lines []*spoolEntry
+	for i := range records {
+		record := records[i]
+		entries[i] = &spoolEntry{Op: spoolOpAppend, Seq: spool.nextSeq + int64(i), ResourceID: resourceID, Record: &record}
+		lines = append(lines, entries[i])
+	}
+	err := spool.write(lines)
+	if err != nil {
+		return nil, err
+	}
+	spool.nextSeq += int64(len(records))
+	for _, entry := range entries {
+		spool.pending[entry.Seq] = entry
+	}
+	return entries, nil
+}
+
+// ack records the acknowledgement of the entries with the sequence numbers "seqs", and compacts the spool if needed.
+func (spool *UsageSpool) ack(seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	var lines []*spoolEntry
+	for _, seq := range seqs {
+		lines = append(lines, &spoolEntry{Op: spoolOpAck, Seq: seq})
+	}
+	err := spool.write(lines)
+	if err != nil {
+		return err
+	}
+	for _, seq := range seqs {
+		delete(spool.pending, seq)
+	}
+	if len(spool.pending) == 0 || spool.segmentBytes > spool.maxSegmentBytes {
+		return spool.compact()
+	}
+	return nil
+}
+
+// write appends "lines" to the current segment and syncs it to disk.
+func (spool *UsageSpool) write(lines []*spoolEntry) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, line := range lines {
+		err := encoder.Encode(line)
+		if err != nil {
+			return fmt.Errorf("error encoding a usage spool entry: %w", err)
+		}
+	}
+	n, err := spool.segment.Write(buf.Bytes())
+	spool.segmentBytes += int64(n)
+	if err == nil {
+		err = spool.segment.Sync()
+	}
+	if err != nil {
+		return fmt.Errorf("error writing the usage spool segment: %w", err)
+	}
+	return nil
+}
+
+// compact writes the pending entries into a new segment, makes it the current segment and removes the previous ones.
+func (spool *UsageSpool) compact() error {
+	if spool.segment == nil {
+		return errors.New("the usage spool is closed")
+	}
+	previous, err := spool.segmentNumbers()
+	if err != nil {
+		return err
+	}
+	err = spool.segment.Close()
+	spool.segment = nil
+	if err != nil {
+		return fmt.Errorf("error closing the usage spool segment: %w", err)
+	}
+
+	err = spool.createSegment(spool.segmentNum + 1)
+	if err != nil {
+		return err
+	}
+	entries := spool.pendingEntries()
+	if len(entries) > 0 {
+		err = spool.write(entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The pending entries are now stored in the new segment, so a crash past this point
+	// at worst leaves entries that are duplicated across segments, which are loaded once.
+	for _, num := range previous {
+		err = os.Remove(spool.segmentPath(num))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing the usage spool segment: %w", err)
+		}
+	}
+	return nil
+}
+
+// createSegment creates the segment numbered "num" and makes it the current segment.
+func (spool *UsageSpool) createSegment(num int) error {
+	segment, err := os.OpenFile(spool.segmentPath(num), os.O_WRONLY|os.O_CREATE|os.O_EXCL|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("error creating the usage spool segment: %w", err)
+	}
+	spool.segment = segment
+	spool.segmentNum = num
+	spool.segmentBytes = 0
+	syncDir(spool.dir)
+	return nil
+}
+
+// load reads the entries of the segment numbered "num". If "last" is true, an incomplete entry at the end of the
+// segment, left by a crash during a write, is removed; otherwise it is an error.
+func (spool *UsageSpool) load(num int, last bool) error {
+	path := spool.segmentPath(num)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading the usage spool segment: %w", err)
+	}
+	if complete := bytes.LastIndexByte(data, '\n') + 1; complete < len(data) {
+		if !last {
+			return fmt.Errorf("the usage spool segment '%s' is truncated", path)
+		}
+		err = os.Truncate(path, int64(complete))
+		if err != nil {
+			return fmt.Errorf("error repairing the usage spool segment: %w", err)
+		}
+		data = data[:complete]
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for lineNum := 1; ; lineNum++ {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr == io.EOF {
+			return nil
+		}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		// Preserve the exact value of the quantities.
+		decoder.UseNumber()
+		entry := &spoolEntry{}
+		err = decoder.Decode(entry)
+		if err != nil {
+			return fmt.Errorf("invalid entry at line %d of the usage spool segment '%s': %w", lineNum, path, err)
+		}
+		switch entry.Op {
+		case spoolOpAppend:
+			if entry.Record == nil {
+				return fmt.Errorf("invalid entry at line %d of the usage spool segment '%s': missing record", lineNum, path)
+			}
+			spool.pending[entry.Seq] = entry
+		case spoolOpAck:
+			delete(spool.pending, entry.Seq)
+		default:
+			return fmt.Errorf("invalid entry at line %d of the usage spool segment '%s': unknown operation '%s'", lineNum, path, entry.Op)
+		}
+		if entry.Seq >= spool.nextSeq {
+			spool.nextSeq = entry.Seq + 1
+		}
+	}
+}
+
+// segmentNumbers returns the numbers of the segments of the spool directory, in increasing order.
+func (spool *UsageSpool) segmentNumbers() ([]int, error) {
+	files, err := os.ReadDir(spool.dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the usage spool directory: %w", err)
+	}
+	var nums []int
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, spoolSegmentPrefix) || !strings.HasSuffix(name, spoolSegmentSuffix) {
+			continue
+		}
+		num, convErr := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, spoolSegmentPrefix), spoolSegmentSuffix))
+		if convErr == nil {
+			nums = append(nums, num)
+		}
+	}
+	slices.Sort(nums)
+	return nums, nil
+}
+
+// segmentPath returns the path of the segment numbered "num".
+func (spool *UsageSpool) segmentPath(num int) string {
+	return filepath.Join(spool.dir, fmt.Sprintf("%s%010d%s", spoolSegmentPrefix, num, spoolSegmentSuffix))
+}
+
+// syncDir syncs the directory "dir" so that the creation of a file survives a crash, where supported.
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+}
@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagemeteringv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageSpool`, func() {
+	var testServer *httptest.Server
+	var service *usagemeteringv4.UsageMeteringV4
+	var dir string
+	// The status returned for each record by instance ID; a record is accepted by default.
+	var statuses map[string]int64
+	var received []string
+
+	start := int64(1700000000000)
+	end := start + 3600000
+	spoolOptions := &usagemeteringv4.UsageSpoolOptions{
+		SubmitterOptions: &usagemeteringv4.UsageSubmitterOptions{MaxAttempts: 1},
+	}
+
+	newRecord := func(instanceID string, quantity interface{}) usagemeteringv4.ResourceInstanceUsage {
+		return usagemeteringv4.ResourceInstanceUsage{
+			ResourceInstanceID: core.StringPtr(instanceID),
+			PlanID:             core.StringPtr("plan"),
+			Start:              core.Int64Ptr(start),
+			End:                core.Int64Ptr(end),
+			MeasuredUsage: []usagemeteringv4.MeasureAndQuantity{
+				{Measure: core.StringPtr("STORAGE"), Quantity: quantity},
+			},
+		}
+	}
+
+	openSpool := func(opts *usagemeteringv4.UsageSpoolOptions) *usagemeteringv4.UsageSpool {
+		spool, err := service.OpenUsageSpool(dir, opts)
+		Expect(err).To(BeNil())
+		Expect(spool).ToNot(BeNil())
+		return spool
+	}
+
+	report := func(spool *usagemeteringv4.UsageSpool, resourceID string, records ...usagemeteringv4.ResourceInstanceUsage) *usagemeteringv4.UsageSubmissionReport {
+		result, err := spool.ReportResourceUsage(context.Background(), service.NewReportResourceUsageOptions(resourceID, records))
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	pendingIDs := func(spool *usagemeteringv4.UsageSpool) (ids []string) {
+		for _, pending := range spool.Pending() {
+			ids = append(ids, pending.ResourceID+"/"+*pending.Record.ResourceInstanceID)
+		}
+		return
+	}
+
+	segments := func() []string {
+		files, err := filepath.Glob(filepath.Join(dir, "segment-*.log"))
+		Expect(err).To(BeNil())
+		return files
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "usage-spool")
+		Expect(err).To(BeNil())
+		statuses = map[string]int64{}
+		received = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			var records []usagemeteringv4.ResourceInstanceUsage
+			Expect(json.NewDecoder(req.Body).Decode(&records)).To(Succeed())
+
+			// Set mock response
+			var resources []map[string]interface{}
+			for _, record := range records {
+				received = append(received, req.URL.EscapedPath()+"#"+*record.ResourceInstanceID)
+				status, ok := statuses[*record.ResourceInstanceID]
+				if !ok {
+					status = 201
+				}
+				resources = append(resources, map[string]interface{}{"status": status, "location": "Location"})
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			Expect(json.NewEncoder(res).Encode(map[string]interface{}{"resources": resources})).To(Succeed())
+		}))
+		service, err = usagemeteringv4.NewUsageMeteringV4(&usagemeteringv4.UsageMeteringV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Acknowledge accepted and rejected records`, func() {
+		statuses["rejected"] = 400
+		spool := openSpool(spoolOptions)
+		defer spool.Close()
+
+		result := report(spool, "resource-1", newRecord("accepted", 1), newRecord("rejected", 1))
+		Expect(result.Accepted).To(HaveLen(1))
+		Expect(result.Failed).To(HaveLen(1))
+		Expect(received).To(Equal([]string{
+			"/v4/metering/resources/resource-1/usage#accepted",
+			"/v4/metering/resources/resource-1/usage#rejected",
+		}))
+		Expect(spool.Pending()).To(BeEmpty())
+
+		// The acknowledged records were compacted away.
+		Expect(segments()).To(HaveLen(1))
+		info, err := os.Stat(segments()[0])
+		Expect(err).To(BeNil())
+		Expect(info.Size()).To(BeZero())
+	})
+	It(`Replay the records that failed with a retryable status after a restart`, func() {
+		statuses["throttled"] = 429
+		statuses["unavailable"] = 503
+		spool := openSpool(spoolOptions)
+		report(spool, "resource-1", newRecord("accepted", 1), newRecord("throttled", 1.5))
+		report(spool, "resource-2", newRecord("unavailable", json.Number("12345678901234567890")))
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/throttled", "resource-2/unavailable"}))
+		Expect(spool.Close()).To(Succeed())
+
+		delete(statuses, "throttled")
+		delete(statuses, "unavailable")
+		received = nil
+		spool = openSpool(spoolOptions)
+		defer spool.Close()
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/throttled", "resource-2/unavailable"}))
+		pending := spool.Pending()
+		Expect(pending[0].Record.MeasuredUsage[0].Quantity).To(Equal(json.Number("1.5")))
+		Expect(pending[1].Record.MeasuredUsage[0].Quantity).To(Equal(json.Number("12345678901234567890")))
+
+		result, err := spool.Replay(context.Background())
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(result.Accepted).To(HaveLen(2))
+		Expect(result.Requests).To(Equal(2))
+		Expect(received).To(Equal([]string{
+			"/v4/metering/resources/resource-1/usage#throttled",
+			"/v4/metering/resources/resource-2/usage#unavailable",
+		}))
+		Expect(spool.Pending()).To(BeEmpty())
+	})
+	It(`Persist records that cannot be sent`, func() {
+		spool := openSpool(spoolOptions)
+		testServer.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		result, err := spool.ReportResourceUsage(ctx, service.NewReportResourceUsageOptions("resource-1", []usagemeteringv4.ResourceInstanceUsage{newRecord("offline", 1)}))
+		Expect(err).To(BeNil())
+		Expect(result.Failed).To(HaveLen(1))
+		Expect(spool.Close()).To(Succeed())
+
+		spool = openSpool(spoolOptions)
+		defer spool.Close()
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/offline"}))
+	})
+	It(`Reject invalid records without persisting them`, func() {
+		spool := openSpool(nil)
+		defer spool.Close()
+
+		invalid := newRecord("invalid", "many")
+		_, err := spool.ReportResourceUsage(context.Background(), service.NewReportResourceUsageOptions("resource-1", []usagemeteringv4.ResourceInstanceUsage{newRecord("valid", 1), invalid}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid usage record 1"))
+		Expect(received).To(BeEmpty())
+		Expect(spool.Pending()).To(BeEmpty())
+	})
+	It(`Compact the spool when a segment grows too large`, func() {
+		statuses["throttled"] = 429
+		opts := *spoolOptions
+		opts.MaxSegmentBytes = 1024
+		spool := openSpool(&opts)
+		defer spool.Close()
+
+		report(spool, "resource-1", newRecord("throttled", 1))
+		for i := 0; i < 10; i++ {
+			report(spool, "resource-1", newRecord("accepted", i))
+		}
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/throttled"}))
+		Expect(segments()).To(HaveLen(1))
+		info, err := os.Stat(segments()[0])
+		Expect(err).To(BeNil())
+		Expect(info.Size()).To(BeNumerically("<=", 1024))
+
+		Expect(spool.Compact()).To(Succeed())
+		Expect(spool.Close()).To(Succeed())
+		spool = openSpool(&opts)
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/throttled"}))
+	})
+	It(`Recover from an interrupted write`, func() {
+		statuses["throttled"] = 429
+		spool := openSpool(spoolOptions)
+		report(spool, "resource-1", newRecord("throttled", 1))
+		Expect(spool.Close()).To(Succeed())
+
+		segment, err := os.OpenFile(segments()[0], os.O_WRONLY|os.O_APPEND, 0o600)
+		Expect(err).To(BeNil())
+		_, err = segment.WriteString(`{"op":"append","seq":2,"resource_id":"resource-1","rec`)
+		Expect(err).To(BeNil())
+		Expect(segment.Close()).To(Succeed())
+
+		spool = openSpool(spoolOptions)
+		Expect(pendingIDs(spool)).To(Equal([]string{"resource-1/throttled"}))
+		report(spool, "resource-1", newRecord("throttled", 2))
+		Expect(spool.Pending()).To(HaveLen(2))
+		Expect(spool.Close()).To(Succeed())
+
+		spool = openSpool(spoolOptions)
+		Expect(spool.Pending()).To(HaveLen(2))
+		Expect(spool.Close()).To(Succeed())
+
+		Expect(os.WriteFile(filepath.Join(dir, "segment-0000000000.log"), []byte("{\"op\":\"append\"\n"), 0o600)).To(Succeed())
+		_, err = service.OpenUsageSpool(dir, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,406 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default values used by UsageSubmitter.
+const (
+	DefaultSubmitMaxBatchSize  = 100
+	DefaultSubmitMaxAttempts   = 3
+	DefaultSubmitRetryInterval = 1 * time.Second
+)
+
+// The range of plausible usage times, in milliseconds since epoch (March 1973 to November 2286).
+// A time outside of this range was most likely specified in seconds or in nanoseconds.
+const (
+	minUsageTimeMillis = int64(100000000000)
+	maxUsageTimeMillis = int64(10000000000000)
+)
+
+// DefaultSubmitRetryableStatuses are the statuses of usage records, or of whole requests, that are retried
+// by UsageSubmitter when UsageSubmitterOptions.RetryableStatuses is not set.
+var DefaultSubmitRetryableStatuses = []int64{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// UsageSubmitterOptions : The UsageSubmitter options.
+// The zero value of each field selects its default.
+type UsageSubmitterOptions struct {
+	// The maximum number of usage records sent in one request. Defaults to DefaultSubmitMaxBatchSize.
+	MaxBatchSize int
+
+	// The maximum size of the JSON body of one request, in bytes. If not set, only MaxBatchSize limits a batch.
+	// A record that exceeds this size on its own is sent alone.
+	MaxBatchBytes int
+
+	// The maximum number of times a usage record is submitted. Defaults to DefaultSubmitMaxAttempts.
+	MaxAttempts int
+
+	// The delay before the first retry, doubled before each subsequent retry. Defaults to DefaultSubmitRetryInterval.
+	RetryInterval time.Duration
+
+	// The statuses for which a usage record is submitted again. Defaults to DefaultSubmitRetryableStatuses.
+	// A request that fails as a whole with one of these statuses, or without a response, is also retried.
+	RetryableStatuses []int64
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// UsageSubmitter : Submits usage records for a resource in batches, with local validation, deduplication and
+// retry of the records that fail with a retryable status. It is created with NewUsageSubmitter.
+type UsageSubmitter struct {
+	service    *UsageMeteringV4
+	resourceID string
+
+	maxBatchSize      int
+	maxBatchBytes     int
+	maxAttempts       int
+	retryInterval     time.Duration
+	retryableStatuses []int64
+	headers           map[string]string
+}
+
+// UsageRecordResult : The outcome of the submission of a usage record.
+type UsageRecordResult struct {
+	// The usage record.
+	Record ResourceInstanceUsage
+
+	// The status of the last submission of the record, or 0 if it was not submitted.
+	Status int64
+
+	// The error code and description reported by the service, or the validation error, if any.
+	Code    string
+	Message string
+
+	// The location of the usage, if it was accepted.
+	Location string
+
+	// The number of times the record was submitted.
+	Attempts int
+
+	// True if the last submission of the record failed transiently.
+	retryable bool
+}
+
+// UsageSubmissionReport : The aggregate outcome of a UsageSubmitter.Submit call.
+type UsageSubmissionReport struct {
+	// The records accepted by the service, including those that it reported as already recorded (status 409).
+	Accepted []UsageRecordResult
+
+	// The records rejected by the service, or that still failed with a retryable status after the last attempt.
+	Failed []UsageRecordResult
+
+	// The records that were not submitted because they are invalid.
+	Invalid []UsageRecordResult
+
+	// The records that were not submitted because they have the same instance, plan, start and end
+	// as a previous record of the submission.
+	Duplicates []ResourceInstanceUsage
+
+	// The number of requests sent.
+	Requests int
+}
+
+// Succeeded returns true if all valid records were accepted and no record was invalid.
+func (report *UsageSubmissionReport) Succeeded() bool {
+	return len(report.Failed) == 0 && len(report.Invalid) == 0
+}
+
+// NewUsageSubmitter returns a UsageSubmitter that reports usage for the resource identified by "resourceID".
+func (usageMetering *UsageMeteringV4) NewUsageSubmitter(resourceID string, opts *UsageSubmitterOptions) *UsageSubmitter {
+	if opts == nil {
+		opts = &UsageSubmitterOptions{}
+	}
+	submitter := &UsageSubmitter{
+		service:           usageMetering,
+		resourceID:        resourceID,
+		maxBatchSize:      opts.MaxBatchSize,
+		maxBatchBytes:     opts.MaxBatchBytes,
+		maxAttempts:       opts.MaxAttempts,
+		retryInterval:     opts.RetryInterval,
+		retryableStatuses: opts.RetryableStatuses,
+		headers:           opts.Headers,
+	}
+	if submitter.maxBatchSize <= 0 {
+		submitter.maxBatchSize = DefaultSubmitMaxBatchSize
+	}
+	if submitter.maxAttempts <= 0 {
+		submitter.maxAttempts = DefaultSubmitMaxAttempts
+	}
+	if submitter.retryInterval <= 0 {
+		submitter.retryInterval = DefaultSubmitRetryInterval
+	}
+	if submitter.retryableStatuses == nil {
+		submitter.retryableStatuses = DefaultSubmitRetryableStatuses
+	}
+	return submitter
+}
+
+// ValidateResourceInstanceUsage checks a usage record before its submission. The instance and plan IDs must not
+// be empty, the start and end times must be in milliseconds since epoch with the start not after the end (they are
+// equal for event-based submissions), and at least one measure must be specified. The quantity of each measure must
+// be a number, or an object with numeric "previous" and "current" values for event-based submissions.
+func ValidateResourceInstanceUsage(record *ResourceInstanceUsage) error {
+	if record == nil {
+		return errors.New("the usage record must not be nil")
+	}
+	if core.StringNilMapper(record.ResourceInstanceID) == "" {
+		return errors.New("the resource instance ID must be specified")
+	}
+	if core.StringNilMapper(record.PlanID) == "" {
+		return errors.New("the plan ID must be specified")
+	}
+	if record.Start == nil || record.End == nil {
+		return errors.New("the start and end times must be specified")
+	}
+	for _, t := range []int64{*record.Start, *record.End} {
+		if t < minUsageTimeMillis || t >= maxUsageTimeMillis {
+			return fmt.Errorf("the time %d is not in milliseconds since epoch", t)
+		}
+	}
+	if *record.Start > *record.End {
+		return fmt.Errorf("the start time %d is after the end time %d", *record.Start, *record.End)
+	}
+	if len(record.MeasuredUsage) == 0 {
+		return errors.New("at least one measure must be specified")
+	}
+	for _, usage := range record.MeasuredUsage {
+		measure := core.StringNilMapper(usage.Measure)
+		if measure == "" {
+			return errors.New("the name of each measure must be specified")
+		}
+		if !isQuantity(usage.Quantity) {
+			return fmt.Errorf("the quantity of measure '%s' is not numeric: %v", measure, usage.Quantity)
+		}
+	}
+	return nil
+}
+
+// isQuantity returns true if "quantity" is a number, or a map with numeric "previous" and "current" values.
+func isQuantity(quantity interface{}) bool {
+	if isNumber(quantity) {
+		return true
+	}
+	value := reflect.ValueOf(quantity)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return false
+	}
+	for _, name := range []string{"previous", "current"} {
+		v := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+		if !v.IsValid() || !isNumber(v.Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumber returns true if "value" is a numeric value.
+func isNumber(value interface{}) bool {
+	if number, ok := value.(json.Number); ok {
+		_, err := number.Float64()
+		return err == nil
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// usageRecordKey identifies a usage record for deduplication.
+type usageRecordKey struct {
+	instanceID string
+	planID     string
+	start      int64
+	end        int64
+}
+
+// Submit validates and deduplicates "records", submits the valid ones in batches and resubmits, in new batches,
+// the records that failed with a retryable status, until they are accepted or their attempts are exhausted.
+//
+// The returned report accounts for every record. An error is returned, along with the report of the submission
+// so far, only if the Context is cancelled or expires; records that were not submitted yet are then reported as
+// failed.
+func (submitter *UsageSubmitter) Submit(ctx context.Context, records []ResourceInstanceUsage) (report *UsageSubmissionReport, err error) {
+	report = &UsageSubmissionReport{}
+
+	var pending []*UsageRecordResult
+	seen := make(map[usageRecordKey]bool)
+	for _, record := range records {
+		if validationErr := ValidateResourceInstanceUsage(&record); validationErr != nil {
+			report.Invalid = append(report.Invalid, UsageRecordResult{Record: record, Message: validationErr.Error()})
+			continue
+		}
+		key := usageRecordKey{*record.ResourceInstanceID, *record.PlanID, *record.Start, *record.End}
+		if seen[key] {
+			report.Duplicates = append(report.Duplicates, record)
+			continue
+		}
+		seen[key] = true
+		pending = append(pending, &UsageRecordResult{Record: record})
+	}
+
+	interval := submitter.retryInterval
+	for attempt := 1; len(pending) > 0; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(interval):
+			}
+			interval *= 2
+		}
+
+		var retry []*UsageRecordResult
+		for _, batch := range submitter.batches(pending) {
+			if ctx.Err() != nil {
+				break
+			}
+			for _, result := range submitter.submitBatch(ctx, batch) {
+				if attempt < submitter.maxAttempts && result.retryable {
+					retry = append(retry, result)
+				} else if isAccepted(result.Status) {
+					report.Accepted = append(report.Accepted, *result)
+				} else {
+					report.Failed = append(report.Failed, *result)
+				}
+			}
+			report.Requests++
+		}
+
+		if ctx.Err() != nil {
+			for _, result := range pending {
+				if result.Attempts < attempt {
+					result.Message = ctx.Err().Error()
+					report.Failed = append(report.Failed, *result)
+				}
+			}
+			for _, result := range retry {
+				report.Failed = append(report.Failed, *result)
+			}
+			err = fmt.Errorf("usage submission interrupted: %w", ctx.Err())
+			return
+		}
+		pending = retry
+	}
+	return
+}
+
+// batches splits "pending" into batches that do not exceed the maximum number of records or body size.
+func (submitter *UsageSubmitter) batches(pending []*UsageRecordResult) (batches [][]*UsageRecordResult) {
+	var batch []*UsageRecordResult
+	var batchBytes int
+	for _, result := range pending {
+		// The size of the record in the JSON array, including its separator.
+		recordBytes := 0
+		if submitter.maxBatchBytes > 0 {
+			encoded, _ := json.Marshal(result.Record)
+			recordBytes = len(encoded) + 1
+		}
+		if len(batch) > 0 && (len(batch) == submitter.maxBatchSize ||
+			(submitter.maxBatchBytes > 0 && batchBytes+recordBytes > submitter.maxBatchBytes)) {
+			batches = append(batches, batch)
+			batch = nil
+		}
+		if len(batch) == 0 {
+			// The brackets of the array, without a separator.
+			batchBytes = 1
+		}
+		batch = append(batch, result)
+		batchBytes += recordBytes
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return
+}
+
+// submitBatch sends the records of "batch" in one request and updates their results.
+func (submitter *UsageSubmitter) submitBatch(ctx context.Context, batch []*UsageRecordResult) []*UsageRecordResult {
+	usage := make([]ResourceInstanceUsage, len(batch))
+	for i, result := range batch {
+		usage[i] = result.Record
+		result.Attempts++
+	}
+	options := submitter.service.NewReportResourceUsageOptions(submitter.resourceID, usage)
+	options.SetHeaders(submitter.headers)
+
+	accepted, response, err := submitter.service.ReportResourceUsageWithContext(ctx, options)
+	if err != nil {
+		// The whole request failed: retry it if it got no response or a retryable status.
+		var status int64
+		if response != nil {
+			status = int64(response.StatusCode)
+		}
+		for _, result := range batch {
+			result.Status = status
+			result.Code = ""
+			result.Message = err.Error()
+			result.retryable = response == nil || submitter.isRetryable(status)
+		}
+		return batch
+	}
+	if len(accepted.Resources) != len(batch) {
+		for _, result := range batch {
+			result.Status = 0
+			result.Message = fmt.Sprintf("the service returned %d statuses for %d usage records", len(accepted.Resources), len(batch))
+			result.retryable = false
+		}
+		return batch
+	}
+
+	for i, details := range accepted.Resources {
+		result := batch[i]
+		result.Status = 0
+		if details.Status != nil {
+			result.Status = *details.Status
+		}
+		result.Location = core.StringNilMapper(details.Location)
+		result.Code = core.StringNilMapper(details.Code)
+		result.Message = core.StringNilMapper(details.Message)
+		result.retryable = submitter.isRetryable(result.Status)
+	}
+	return batch
+}
+
+// isRetryable returns true if a record with "status" must be submitted again.
+func (submitter *UsageSubmitter) isRetryable(status int64) bool {
+	return slices.Contains(submitter.retryableStatuses, status)
+}
+
+// isAccepted returns true if "status" indicates that a usage record was recorded.
+func isAccepted(status int64) bool {
+	return (status >= 200 && status < 300) || status == http.StatusConflict
+}
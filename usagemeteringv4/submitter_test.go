@@ -0,0 +1,248 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagemeteringv4_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagemeteringv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UsageSubmitter`, func() {
+	var testServer *httptest.Server
+	var batches [][]string
+	// The statuses returned for the successive submissions of a record, by instance ID; a record is accepted by default.
+	var statuses map[string][]int64
+	// The status of the successive requests as a whole; requests succeed by default.
+	var requestStatuses []int
+
+	reportResourceUsagePath := "/v4/metering/resources/testString/usage"
+	start := int64(1700000000000)
+	end := start + 3600000
+
+	newRecord := func(instanceID string, start int64, end int64, quantity interface{}) usagemeteringv4.ResourceInstanceUsage {
+		return usagemeteringv4.ResourceInstanceUsage{
+			ResourceInstanceID: core.StringPtr(instanceID),
+			PlanID:             core.StringPtr("plan"),
+			Start:              core.Int64Ptr(start),
+			End:                core.Int64Ptr(end),
+			MeasuredUsage: []usagemeteringv4.MeasureAndQuantity{
+				{Measure: core.StringPtr("STORAGE"), Quantity: quantity},
+			},
+		}
+	}
+
+	newSubmitter := func(opts *usagemeteringv4.UsageSubmitterOptions) *usagemeteringv4.UsageSubmitter {
+		usageMeteringService, serviceErr := usagemeteringv4.NewUsageMeteringV4(&usagemeteringv4.UsageMeteringV4Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		if opts.RetryInterval == 0 {
+			opts.RetryInterval = time.Millisecond
+		}
+		return usageMeteringService.NewUsageSubmitter("testString", opts)
+	}
+
+	instanceIDs := func(results []usagemeteringv4.UsageRecordResult) (ids []string) {
+		for _, result := range results {
+			ids = append(ids, *result.Record.ResourceInstanceID)
+		}
+		return
+	}
+
+	BeforeEach(func() {
+		batches = nil
+		statuses = map[string][]int64{}
+		requestStatuses = nil
+		submissions := map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal(reportResourceUsagePath))
+			Expect(req.Method).To(Equal("POST"))
+			var records []usagemeteringv4.ResourceInstanceUsage
+			Expect(json.NewDecoder(req.Body).Decode(&records)).To(Succeed())
+
+			requestNumber := len(batches)
+			var ids []string
+			for _, record := range records {
+				ids = append(ids, *record.ResourceInstanceID)
+			}
+			batches = append(batches, ids)
+			if requestNumber < len(requestStatuses) && requestStatuses[requestNumber] != 0 {
+				res.WriteHeader(requestStatuses[requestNumber])
+				return
+			}
+
+			// Set mock response
+			var resources []map[string]interface{}
+			for _, id := range ids {
+				status := int64(201)
+				if n := submissions[id]; n < len(statuses[id]) {
+					status = statuses[id][n]
+				}
+				submissions[id]++
+				resource := map[string]interface{}{"status": status, "location": "/usage/" + id}
+				if status >= 400 {
+					resource["code"] = fmt.Sprintf("E%d", status)
+					resource["message"] = "Error"
+				}
+				resources = append(resources, resource)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			Expect(json.NewEncoder(res).Encode(map[string]interface{}{"resources": resources})).To(Succeed())
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Submit records in batches`, func() {
+		var records []usagemeteringv4.ResourceInstanceUsage
+		for i := 0; i < 5; i++ {
+			records = append(records, newRecord(fmt.Sprintf("instance-%d", i), start, end, i))
+		}
+
+		report, err := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{MaxBatchSize: 2}).Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeTrue())
+		Expect(report.Requests).To(Equal(3))
+		Expect(batches).To(Equal([][]string{{"instance-0", "instance-1"}, {"instance-2", "instance-3"}, {"instance-4"}}))
+		Expect(report.Accepted).To(HaveLen(5))
+		Expect(report.Accepted[4].Status).To(Equal(int64(201)))
+		Expect(report.Accepted[4].Location).To(Equal("/usage/instance-4"))
+		Expect(report.Accepted[4].Attempts).To(Equal(1))
+	})
+	It(`Limit the size of the batches`, func() {
+		records := []usagemeteringv4.ResourceInstanceUsage{
+			newRecord("instance-0", start, end, 1),
+			newRecord("instance-1", start, end, 1),
+			newRecord("instance-2", start, end, 1),
+		}
+		encoded, err := json.Marshal(records[:2])
+		Expect(err).To(BeNil())
+
+		report, err := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{MaxBatchBytes: len(encoded)}).Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeTrue())
+		Expect(batches).To(Equal([][]string{{"instance-0", "instance-1"}, {"instance-2"}}))
+
+		batches = nil
+		_, err = newSubmitter(&usagemeteringv4.UsageSubmitterOptions{MaxBatchBytes: 10}).Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(batches).To(HaveLen(3))
+	})
+	It(`Validate and deduplicate records`, func() {
+		emptyUsage := newRecord("empty-usage", start, end, 1)
+		emptyUsage.MeasuredUsage = nil
+		records := []usagemeteringv4.ResourceInstanceUsage{
+			newRecord("valid", start, end, 1.5),
+			newRecord("valid", start, end, 2),
+			newRecord("event", start, start, map[string]interface{}{"previous": 1, "current": 2}),
+			newRecord("seconds", start/1000, end/1000, 1),
+			newRecord("reversed", end, start, 1),
+			newRecord("string-quantity", start, end, "1"),
+			newRecord("bad-event", start, start, map[string]interface{}{"previous": 1}),
+			emptyUsage,
+			{PlanID: core.StringPtr("plan")},
+		}
+
+		report, err := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{}).Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeFalse())
+		Expect(batches).To(Equal([][]string{{"valid", "event"}}))
+		Expect(instanceIDs(report.Accepted)).To(Equal([]string{"valid", "event"}))
+		Expect(report.Duplicates).To(HaveLen(1))
+		Expect(report.Invalid).To(HaveLen(6))
+		Expect(report.Invalid[0].Message).To(ContainSubstring("not in milliseconds"))
+		Expect(report.Invalid[1].Message).To(ContainSubstring("after the end time"))
+		Expect(report.Invalid[2].Message).To(ContainSubstring("not numeric"))
+		Expect(report.Invalid[3].Message).To(ContainSubstring("not numeric"))
+		Expect(report.Invalid[4].Message).To(ContainSubstring("at least one measure"))
+		Expect(report.Invalid[5].Message).To(ContainSubstring("resource instance ID"))
+		Expect(report.Invalid[5].Status).To(Equal(int64(0)))
+	})
+	It(`Retry only the records that failed with a retryable status`, func() {
+		statuses["throttled"] = []int64{429, 503}
+		statuses["rejected"] = []int64{400}
+		statuses["recorded"] = []int64{409}
+		statuses["unavailable"] = []int64{500, 500, 500}
+		records := []usagemeteringv4.ResourceInstanceUsage{
+			newRecord("accepted", start, end, 1),
+			newRecord("throttled", start, end, 1),
+			newRecord("rejected", start, end, 1),
+			newRecord("recorded", start, end, 1),
+			newRecord("unavailable", start, end, 1),
+		}
+
+		report, err := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{}).Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(batches).To(Equal([][]string{
+			{"accepted", "throttled", "rejected", "recorded", "unavailable"},
+			{"throttled", "unavailable"},
+			{"throttled", "unavailable"},
+		}))
+		Expect(instanceIDs(report.Accepted)).To(Equal([]string{"accepted", "recorded", "throttled"}))
+		Expect(report.Accepted[2].Attempts).To(Equal(3))
+		Expect(instanceIDs(report.Failed)).To(Equal([]string{"rejected", "unavailable"}))
+		Expect(report.Failed[0].Code).To(Equal("E400"))
+		Expect(report.Failed[1].Status).To(Equal(int64(500)))
+		Expect(report.Failed[1].Attempts).To(Equal(3))
+	})
+	It(`Retry requests that failed as a whole`, func() {
+		requestStatuses = []int{503, 0, 400}
+		records := []usagemeteringv4.ResourceInstanceUsage{newRecord("instance-0", start, end, 1)}
+
+		submitter := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{})
+		report, err := submitter.Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeTrue())
+		Expect(report.Requests).To(Equal(2))
+
+		report, err = submitter.Submit(context.Background(), records)
+		Expect(err).To(BeNil())
+		Expect(report.Failed).To(HaveLen(1))
+		Expect(report.Failed[0].Status).To(Equal(int64(400)))
+		Expect(report.Failed[0].Attempts).To(Equal(1))
+	})
+	It(`Stop when the context is cancelled`, func() {
+		statuses["instance-0"] = []int64{429}
+		records := []usagemeteringv4.ResourceInstanceUsage{
+			newRecord("instance-0", start, end, 1),
+			newRecord("instance-1", start, end, 1),
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		report, err := newSubmitter(&usagemeteringv4.UsageSubmitterOptions{RetryInterval: time.Minute}).Submit(ctx, records)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(instanceIDs(report.Accepted)).To(Equal([]string{"instance-1"}))
+		Expect(instanceIDs(report.Failed)).To(Equal([]string{"instance-0"}))
+		Expect(report.Failed[0].Status).To(Equal(int64(429)))
+	})
+})
@@ -0,0 +1,491 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package analysis normalizes the usage returned by usagereportsv4 into a flat cost table, which can be
+// grouped by resource, plan, resource group, region or tag, and compared between two months:
+//
+//	previous := analysis.FromInstancesUsage(aprilUsage.Resources)
+//	current := analysis.FromInstancesUsage(mayUsage.Resources)
+//	deltas := analysis.Compare(previous, current, analysis.DimensionResource, analysis.DimensionPlan)
+//	for _, delta := range analysis.TopMovers(deltas, 10) {
+//		fmt.Printf("%v: %+.2f\n", delta.Key, delta.Change)
+//	}
+//
+// A cost table holds one entry per metric of a plan (of a resource instance, if known). The costs of an entry are
+// those reported by the service: Cost is the cost after discounts, and RatedCost the cost before discounts.
+// The entries of a table are assumed to be in the same currency.
+package analysis
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// Dimension : A dimension by which the entries of a cost table are grouped.
+type Dimension string
+
+// The dimensions of a cost table. Use TagKey to group by the value of a tag.
+const (
+	DimensionAccount          Dimension = "account"
+	DimensionMonth            Dimension = "month"
+	DimensionResource         Dimension = "resource"
+	DimensionResourceInstance Dimension = "resource_instance"
+	DimensionPlan             Dimension = "plan"
+	DimensionResourceGroup    Dimension = "resource_group"
+	DimensionRegion           Dimension = "region"
+	DimensionMetric           Dimension = "metric"
+	DimensionTag              Dimension = "tag"
+)
+
+// tagKeyPrefix is the prefix of the dimensions returned by TagKey.
+const tagKeyPrefix = "tag:"
+
+// TagKey returns the dimension that groups entries by the value of their "key:value" tags with the specified key.
+func TagKey(key string) Dimension {
+	return Dimension(tagKeyPrefix + key)
+}
+
+// Valid returns true if the dimension is one of the Dimension constants, or was returned by TagKey with a non-empty
+// key.
+func (dimension Dimension) Valid() bool {
+	switch dimension {
+	case DimensionAccount, DimensionMonth, DimensionResource, DimensionResourceInstance, DimensionPlan,
+		DimensionResourceGroup, DimensionRegion, DimensionMetric, DimensionTag:
+		return true
+	}
+	key, ok := strings.CutPrefix(string(dimension), tagKeyPrefix)
+	return ok && key != ""
+}
+
+// CostEntry : The cost of a metric of a plan in a month.
+type CostEntry struct {
+	AccountID            string
+	Month                string
+	CurrencyCode         string
+	ResourceGroupID      string
+	ResourceGroupName    string
+	ResourceID           string
+	ResourceName         string
+	ResourceInstanceID   string
+	ResourceInstanceName string
+	PlanID               string
+	PlanName             string
+	Region               string
+	Billable             bool
+	Metric               string
+	MetricName           string
+	Unit                 string
+	Quantity             float64
+	Cost                 float64
+	RatedCost            float64
+
+	// The user tags of the resource instance, if known, typically in the "key:value" format.
+	Tags []string
+}
+
+// CostTable : A flat list of costs.
+type CostTable []CostEntry
+
+// CostGroup : The total costs of the entries of a cost table that share the same values for a set of dimensions.
+type CostGroup struct {
+	// The values of the dimensions, in the order in which the dimensions were specified.
+	// The value of a missing attribute (e.g. the region of an entry without region) is empty.
+	Key []string
+
+	Cost      float64
+	RatedCost float64
+
+	// The number of entries in the group.
+	Entries int
+}
+
+// CostDelta : The change of the cost of a group between two cost tables.
+type CostDelta struct {
+	// The values of the dimensions of the group, in the order in which the dimensions were specified.
+	Key []string
+
+	Previous float64
+	Current  float64
+
+	// The difference between the current and the previous cost.
+	Change float64
+
+	// The change relative to the previous cost, in percent, or 0 if the previous cost is 0.
+	ChangePercent float64
+
+	// True if the group only exists in the current table, or only in the previous table.
+	Added   bool
+	Removed bool
+}
+
+// FromAccountUsage returns the cost table of the usage of an account.
+func FromAccountUsage(usage *usagereportsv4.AccountUsage) CostTable {
+	if usage == nil {
+		return nil
+	}
+	base := CostEntry{
+		AccountID:    core.StringNilMapper(usage.AccountID),
+		Month:        core.StringNilMapper(usage.Month),
+		CurrencyCode: core.StringNilMapper(usage.CurrencyCode),
+	}
+	return fromResources(base, usage.Resources)
+}
+
+// FromResourceGroupUsage returns the cost table of the usage of a resource group.
+func FromResourceGroupUsage(usage *usagereportsv4.ResourceGroupUsage) CostTable {
+	if usage == nil {
+		return nil
+	}
+	base := CostEntry{
+		AccountID:         core.StringNilMapper(usage.AccountID),
+		Month:             core.StringNilMapper(usage.Month),
+		CurrencyCode:      core.StringNilMapper(usage.CurrencyCode),
+		ResourceGroupID:   core.StringNilMapper(usage.ResourceGroupID),
+		ResourceGroupName: core.StringNilMapper(usage.ResourceGroupName),
+	}
+	return fromResources(base, usage.Resources)
+}
+
+// FromInstancesUsage returns the cost table of the usage of resource instances, e.g. the Resources of
+// the InstancesUsage returned by GetResourceUsageAccount (possibly over several pages).
+func FromInstancesUsage(instances []usagereportsv4.InstanceUsage) CostTable {
+	var table CostTable
+	for _, instance := range instances {
+		entry := CostEntry{
+			AccountID:            core.StringNilMapper(instance.AccountID),
+			Month:                core.StringNilMapper(instance.Month),
+			CurrencyCode:         core.StringNilMapper(instance.CurrencyCode),
+			ResourceGroupID:      core.StringNilMapper(instance.ResourceGroupID),
+			ResourceGroupName:    core.StringNilMapper(instance.ResourceGroupName),
+			ResourceID:           core.StringNilMapper(instance.ResourceID),
+			ResourceName:         core.StringNilMapper(instance.ResourceName),
+			ResourceInstanceID:   core.StringNilMapper(instance.ResourceInstanceID),
+			ResourceInstanceName: core.StringNilMapper(instance.ResourceInstanceName),
+			PlanID:               core.StringNilMapper(instance.PlanID),
+			PlanName:             core.StringNilMapper(instance.PlanName),
+			Region:               core.StringNilMapper(instance.Region),
+			Billable:             instance.Billable != nil && *instance.Billable,
+			Tags:                 tagStrings(instance.Tags),
+		}
+		if entry.Region == "" {
+			entry.Region = core.StringNilMapper(instance.PricingRegion)
+		}
+		table = append(table, fromMetrics(entry, instance.Usage)...)
+	}
+	return table
+}
+
+// FromAccountResourceInstanceUsageReport returns the cost table of an account_resource_instance_usage report
+// of a billing snapshot.
+func FromAccountResourceInstanceUsageReport(report *usagereportsv4.AccountResourceInstanceUsageReport) CostTable {
+	if report == nil {
+		return nil
+	}
+	var table CostTable
+	for _, row := range report.Rows {
+		entry := CostEntry{
+			AccountID:            row.AccountID,
+			Month:                row.Month,
+			CurrencyCode:         row.CurrencyCode,
+			ResourceGroupID:      row.ResourceGroupID,
+			ResourceGroupName:    row.ResourceGroupName,
+			ResourceID:           row.ResourceID,
+			ResourceName:         row.ResourceName,
+			ResourceInstanceID:   row.ResourceInstanceID,
+			ResourceInstanceName: row.ResourceInstanceName,
+			PlanID:               row.PlanID,
+			PlanName:             row.PlanName,
+			Region:               row.Region,
+			Billable:             row.Billable,
+			Metric:               row.Metric,
+			MetricName:           row.MetricName,
+			Unit:                 row.Unit,
+			Quantity:             row.Quantity,
+			Cost:                 row.Cost,
+			RatedCost:            row.RatedCost,
+			Tags:                 row.Tags,
+		}
+		if entry.AccountID == "" {
+			entry.AccountID = report.Metadata.AccountID
+		}
+		if entry.Month == "" {
+			entry.Month = report.Metadata.Month
+		}
+		if entry.CurrencyCode == "" {
+			entry.CurrencyCode = report.Metadata.BillingCurrencyCode
+		}
+		if entry.Region == "" {
+			entry.Region = row.PricingRegion
+		}
+		table = append(table, entry)
+	}
+	return table
+}
+
+// fromResources returns the entries of the plans of "resources", based on "base".
+func fromResources(base CostEntry, resources []usagereportsv4.Resource) CostTable {
+	var table CostTable
+	for _, resource := range resources {
+		for _, plan := range resource.Plans {
+			entry := base
+			entry.ResourceID = core.StringNilMapper(resource.ResourceID)
+			entry.ResourceName = core.StringNilMapper(resource.ResourceName)
+			entry.PlanID = core.StringNilMapper(plan.PlanID)
+			entry.PlanName = core.StringNilMapper(plan.PlanName)
+			entry.Region = core.StringNilMapper(plan.PricingRegion)
+			entry.Billable = plan.Billable != nil && *plan.Billable
+			if len(plan.Usage) == 0 {
+				// Keep the cost of a plan without metrics.
+				entry.Cost = derefFloat(plan.Cost)
+				entry.RatedCost = derefFloat(plan.RatedCost)
+				table = append(table, entry)
+				continue
+			}
+			table = append(table, fromMetrics(entry, plan.Usage)...)
+		}
+	}
+	return table
+}
+
+// fromMetrics returns the entries of "metrics", based on "base".
+func fromMetrics(base CostEntry, metrics []usagereportsv4.Metric) CostTable {
+	table := make(CostTable, 0, len(metrics))
+	for _, metric := range metrics {
+		entry := base
+		entry.Metric = core.StringNilMapper(metric.Metric)
+		entry.MetricName = core.StringNilMapper(metric.MetricName)
+		entry.Unit = core.StringNilMapper(metric.Unit)
+		entry.Quantity = derefFloat(metric.Quantity)
+		entry.Cost = derefFloat(metric.Cost)
+		entry.RatedCost = derefFloat(metric.RatedCost)
+		table = append(table, entry)
+	}
+	return table
+}
+
+// Total returns the total cost and rated cost of the entries of the table.
+func (table CostTable) Total() (cost float64, ratedCost float64) {
+	for _, entry := range table {
+		cost += entry.Cost
+		ratedCost += entry.RatedCost
+	}
+	return
+}
+
+// Filter returns the entries of the table for which "keep" returns true.
+func (table CostTable) Filter(keep func(entry *CostEntry) bool) CostTable {
+	var filtered CostTable
+	for i := range table {
+		if keep(&table[i]) {
+			filtered = append(filtered, table[i])
+		}
+	}
+	return filtered
+}
+
+// GroupBy returns the total costs of the entries of the table grouped by "dimensions", ordered by decreasing cost
+// (and then by key). Resources, plans, resource instances and resource groups are identified by their ID.
+//
+// When grouping by DimensionTag or TagKey, an entry with several matching tags is counted in the group of each
+// tag, so the total cost of the groups can exceed the total cost of the table; an entry without matching tag
+// is counted in the group with an empty tag.
+//
+// GroupBy panics if one of the dimensions is not valid, since the entries would otherwise all be counted in a
+// group with an empty value of that dimension.
+func (table CostTable) GroupBy(dimensions ...Dimension) []CostGroup {
+	for _, dimension := range dimensions {
+		if !dimension.Valid() {
+			panic(fmt.Sprintf("analysis: invalid dimension '%s'", dimension))
+		}
+	}
+
+	groups := make(map[string]*CostGroup)
+	for i := range table {
+		for _, key := range keys(&table[i], dimensions) {
+			id := groupID(key)
+			group := groups[id]
+			if group == nil {
+				group = &CostGroup{Key: key}
+				groups[id] = group
+			}
+			group.Cost += table[i].Cost
+			group.RatedCost += table[i].RatedCost
+			group.Entries++
+		}
+	}
+
+	result := make([]CostGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	slices.SortFunc(result, func(a, b CostGroup) int {
+		if c := cmp.Compare(b.Cost, a.Cost); c != 0 {
+			return c
+		}
+		return slices.Compare(a.Key, b.Key)
+	})
+	return result
+}
+
+// Compare returns the change of the cost of each group of entries between the "previous" and the "current"
+// tables (e.g. of two consecutive months), with the entries grouped by "dimensions" as with GroupBy.
+// The deltas are ordered by key. Compare panics if one of the dimensions is not valid.
+func Compare(previous CostTable, current CostTable, dimensions ...Dimension) []CostDelta {
+	deltas := make(map[string]*CostDelta)
+	for _, group := range previous.GroupBy(dimensions...) {
+		deltas[groupID(group.Key)] = &CostDelta{Key: group.Key, Previous: group.Cost, Removed: true}
+	}
+	for _, group := range current.GroupBy(dimensions...) {
+		id := groupID(group.Key)
+		delta := deltas[id]
+		if delta == nil {
+			delta = &CostDelta{Key: group.Key, Added: true}
+			deltas[id] = delta
+		}
+		delta.Current = group.Cost
+		delta.Removed = false
+	}
+
+	result := make([]CostDelta, 0, len(deltas))
+	for _, delta := range deltas {
+		delta.Change = delta.Current - delta.Previous
+		if delta.Previous != 0 {
+			delta.ChangePercent = delta.Change / math.Abs(delta.Previous) * 100
+		}
+		result = append(result, *delta)
+	}
+	slices.SortFunc(result, func(a, b CostDelta) int {
+		return slices.Compare(a.Key, b.Key)
+	})
+	return result
+}
+
+// TopMovers returns the "n" deltas with the largest absolute change, ordered by decreasing absolute change.
+// Deltas without change are ignored.
+func TopMovers(deltas []CostDelta, n int) []CostDelta {
+	var movers []CostDelta
+	for _, delta := range deltas {
+		if delta.Change != 0 {
+			movers = append(movers, delta)
+		}
+	}
+	slices.SortStableFunc(movers, func(a, b CostDelta) int {
+		return cmp.Compare(math.Abs(b.Change), math.Abs(a.Change))
+	})
+	if n >= 0 && len(movers) > n {
+		movers = movers[:n]
+	}
+	return movers
+}
+
+// keys returns the keys of the groups of "entry": one key, unless a tag dimension matches several tags.
+func keys(entry *CostEntry, dimensions []Dimension) [][]string {
+	result := [][]string{make([]string, 0, len(dimensions))}
+	for _, dimension := range dimensions {
+		values := dimensionValues(entry, dimension)
+		var next [][]string
+		for _, key := range result {
+			for _, value := range values {
+				next = append(next, append(slices.Clone(key), value))
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// dimensionValues returns the values of "dimension", which must be valid, for "entry".
+func dimensionValues(entry *CostEntry, dimension Dimension) []string {
+	switch dimension {
+	case DimensionAccount:
+		return []string{entry.AccountID}
+	case DimensionMonth:
+		return []string{entry.Month}
+	case DimensionResource:
+		return []string{entry.ResourceID}
+	case DimensionResourceInstance:
+		return []string{entry.ResourceInstanceID}
+	case DimensionPlan:
+		return []string{entry.PlanID}
+	case DimensionResourceGroup:
+		return []string{entry.ResourceGroupID}
+	case DimensionRegion:
+		return []string{entry.Region}
+	case DimensionMetric:
+		return []string{entry.Metric}
+	case DimensionTag:
+		if len(entry.Tags) == 0 {
+			return []string{""}
+		}
+		return slices.Compact(slices.Sorted(slices.Values(entry.Tags)))
+	}
+
+	if key, ok := strings.CutPrefix(string(dimension), tagKeyPrefix); ok {
+		var values []string
+		for _, tag := range entry.Tags {
+			if k, v, found := strings.Cut(tag, ":"); found && strings.TrimSpace(k) == key {
+				values = append(values, strings.TrimSpace(v))
+			}
+		}
+		if len(values) == 0 {
+			return []string{""}
+		}
+		return slices.Compact(slices.Sorted(slices.Values(values)))
+	}
+	panic(fmt.Sprintf("analysis: invalid dimension '%s'", dimension))
+}
+
+// groupID returns a string that identifies the group with "key".
+func groupID(key []string) string {
+	return strings.Join(key, "\x00")
+}
+
+// tagStrings returns the tags of a resource instance as strings. The tags are either strings, or objects with
+// a "key" (or "name") and a "value".
+func tagStrings(tags []interface{}) []string {
+	var result []string
+	for _, tag := range tags {
+		switch t := tag.(type) {
+		case string:
+			result = append(result, t)
+		case map[string]interface{}:
+			key, _ := t["key"].(string)
+			if key == "" {
+				key, _ = t["name"].(string)
+			}
+			if value, ok := t["value"]; ok && value != nil {
+				result = append(result, fmt.Sprintf("%s:%v", key, value))
+			} else if key != "" {
+				result = append(result, key)
+			}
+		}
+	}
+	return result
+}
+
+// derefFloat returns the value of "f", or 0 if it is nil.
+func derefFloat(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func metric(name string, quantity float64, cost float64) usagereportsv4.Metric {
+	return usagereportsv4.Metric{
+		Metric:    core.StringPtr(name),
+		Quantity:  core.Float64Ptr(quantity),
+		Cost:      core.Float64Ptr(cost),
+		RatedCost: core.Float64Ptr(cost * 1.25),
+	}
+}
+
+func instance(id string, resourceID string, planID string, region string, tags []interface{}, metrics ...usagereportsv4.Metric) usagereportsv4.InstanceUsage {
+	return usagereportsv4.InstanceUsage{
+		AccountID:          core.StringPtr("account"),
+		Month:              core.StringPtr("2025-05"),
+		CurrencyCode:       core.StringPtr("USD"),
+		ResourceInstanceID: core.StringPtr(id),
+		ResourceID:         core.StringPtr(resourceID),
+		ResourceGroupID:    core.StringPtr("rg-" + resourceID),
+		PlanID:             core.StringPtr(planID),
+		Region:             core.StringPtr(region),
+		Billable:           core.BoolPtr(true),
+		Tags:               tags,
+		Usage:              metrics,
+	}
+}
+
+func TestFromAccountUsage(t *testing.T) {
+	usage := &usagereportsv4.AccountUsage{
+		AccountID:    core.StringPtr("account"),
+		Month:        core.StringPtr("2025-05"),
+		CurrencyCode: core.StringPtr("USD"),
+		Resources: []usagereportsv4.Resource{
+			{
+				ResourceID:   core.StringPtr("cloud-object-storage"),
+				ResourceName: core.StringPtr("Cloud Object Storage"),
+				Plans: []usagereportsv4.Plan{
+					{
+						PlanID:        core.StringPtr("standard"),
+						PricingRegion: core.StringPtr("global"),
+						Billable:      core.BoolPtr(true),
+						Usage:         []usagereportsv4.Metric{metric("STORAGE", 100, 10), metric("CALLS", 1000, 2)},
+					},
+					{
+						PlanID:    core.StringPtr("lite"),
+						Billable:  core.BoolPtr(false),
+						Cost:      core.Float64Ptr(0.5),
+						RatedCost: core.Float64Ptr(1),
+					},
+				},
+			},
+		},
+	}
+
+	table := FromAccountUsage(usage)
+	assert.Len(t, table, 3)
+	assert.Equal(t, CostEntry{
+		AccountID:    "account",
+		Month:        "2025-05",
+		CurrencyCode: "USD",
+		ResourceID:   "cloud-object-storage",
+		ResourceName: "Cloud Object Storage",
+		PlanID:       "standard",
+		Region:       "global",
+		Billable:     true,
+		Metric:       "CALLS",
+		Quantity:     1000,
+		Cost:         2,
+		RatedCost:    2.5,
+	}, table[1])
+	assert.Equal(t, "lite", table[2].PlanID)
+	assert.Equal(t, "", table[2].Metric)
+	cost, ratedCost := table.Total()
+	assert.Equal(t, 12.5, cost)
+	assert.Equal(t, 16.0, ratedCost)
+
+	group := &usagereportsv4.ResourceGroupUsage{ResourceGroupID: core.StringPtr("rg"), Resources: usage.Resources}
+	assert.Equal(t, "rg", FromResourceGroupUsage(group)[0].ResourceGroupID)
+	assert.Nil(t, FromAccountUsage(nil))
+}
+
+func TestFromAccountResourceInstanceUsageReport(t *testing.T) {
+	report := &usagereportsv4.AccountResourceInstanceUsageReport{
+		Metadata: usagereportsv4.SnapshotReportMetadata{AccountID: "account", Month: "2025-05", BillingCurrencyCode: "EUR"},
+		Rows: []usagereportsv4.AccountResourceInstanceUsageReportRow{
+			{ResourceInstanceID: "instance", ResourceID: "kms", PricingRegion: "us-south", Metric: "KEYS", Cost: 3, Tags: []string{"env:prod"}},
+		},
+	}
+
+	table := FromAccountResourceInstanceUsageReport(report)
+	assert.Len(t, table, 1)
+	assert.Equal(t, "account", table[0].AccountID)
+	assert.Equal(t, "EUR", table[0].CurrencyCode)
+	assert.Equal(t, "us-south", table[0].Region)
+	assert.Equal(t, 3.0, table[0].Cost)
+	assert.Equal(t, []string{"env:prod"}, table[0].Tags)
+	assert.Equal(t, []string{"prod"}, table.GroupBy(TagKey("env"))[0].Key)
+}
+
+func TestGroupBy(t *testing.T) {
+	table := FromInstancesUsage([]usagereportsv4.InstanceUsage{
+		instance("cos-1", "cloud-object-storage", "standard", "global", []interface{}{"env:prod", "team:data"},
+			metric("STORAGE", 100, 10), metric("CALLS", 1000, 2)),
+		instance("cos-2", "cloud-object-storage", "standard", "global", []interface{}{"env:dev"},
+			metric("STORAGE", 50, 5)),
+		instance("kms-1", "kms", "tiered", "us-south", []interface{}{map[string]interface{}{"key": "env", "value": "prod"}},
+			metric("KEYS", 10, 20)),
+		instance("kms-2", "kms", "tiered", "eu-de", nil,
+			metric("KEYS", 1, 1)),
+	})
+	assert.Len(t, table, 5)
+	assert.Equal(t, []string{"env:prod"}, table[3].Tags)
+
+	assert.Equal(t, []CostGroup{
+		{Key: []string{"kms"}, Cost: 21, RatedCost: 26.25, Entries: 2},
+		{Key: []string{"cloud-object-storage"}, Cost: 17, RatedCost: 21.25, Entries: 3},
+	}, table.GroupBy(DimensionResource))
+
+	groups := table.GroupBy(DimensionResourceGroup, DimensionRegion)
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []string{"rg-kms", "us-south"}, groups[0].Key)
+
+	assert.Equal(t, []CostGroup{
+		{Key: []string{"prod"}, Cost: 32, RatedCost: 40, Entries: 3},
+		{Key: []string{"dev"}, Cost: 5, RatedCost: 6.25, Entries: 1},
+		{Key: []string{""}, Cost: 1, RatedCost: 1.25, Entries: 1},
+	}, table.GroupBy(TagKey("env")))
+
+	tagGroups := table.GroupBy(DimensionTag)
+	assert.Len(t, tagGroups, 4)
+	assert.Equal(t, []string{"env:prod"}, tagGroups[0].Key)
+	assert.Equal(t, 3, tagGroups[0].Entries)
+
+	assert.Equal(t, []CostGroup{{Key: []string{}, Cost: 38, RatedCost: 47.5, Entries: 5}}, table.GroupBy())
+
+	storage := table.Filter(func(entry *CostEntry) bool { return entry.Metric == "STORAGE" })
+	assert.Len(t, storage, 2)
+	// An unknown dimension is rejected rather than grouping all the entries under an empty value.
+	assert.True(t, TagKey("env").Valid())
+	assert.False(t, TagKey("").Valid())
+	assert.False(t, Dimension("service").Valid())
+	assert.PanicsWithValue(t, "analysis: invalid dimension 'service'", func() { table.GroupBy(DimensionResource, "service") })
+	assert.Panics(t, func() { CostTable{}.GroupBy(TagKey("")) })
+}
+
+func TestCompare(t *testing.T) {
+	previous := FromInstancesUsage([]usagereportsv4.InstanceUsage{
+		instance("cos-1", "cloud-object-storage", "standard", "global", nil, metric("STORAGE", 100, 10)),
+		instance("kms-1", "kms", "tiered", "us-south", nil, metric("KEYS", 10, 20)),
+		instance("db-1", "databases-for-postgresql", "standard", "us-south", nil, metric("RAM", 10, 50)),
+		instance("cd-1", "continuous-delivery", "lite", "us-south", nil, metric("USERS", 1, 0)),
+	})
+	current := FromInstancesUsage([]usagereportsv4.InstanceUsage{
+		instance("cos-1", "cloud-object-storage", "standard", "global", nil, metric("STORAGE", 150, 15)),
+		instance("kms-1", "kms", "tiered", "us-south", nil, metric("KEYS", 10, 20)),
+		instance("es-1", "messagehub", "standard", "us-south", nil, metric("PARTITIONS", 10, 30)),
+		instance("cd-1", "continuous-delivery", "lite", "us-south", nil, metric("USERS", 1, 0)),
+	})
+
+	deltas := Compare(previous, current, DimensionResource)
+	assert.Equal(t, []CostDelta{
+		{Key: []string{"cloud-object-storage"}, Previous: 10, Current: 15, Change: 5, ChangePercent: 50},
+		{Key: []string{"continuous-delivery"}},
+		{Key: []string{"databases-for-postgresql"}, Previous: 50, Change: -50, ChangePercent: -100, Removed: true},
+		{Key: []string{"kms"}, Previous: 20, Current: 20},
+		{Key: []string{"messagehub"}, Current: 30, Change: 30, Added: true},
+	}, deltas)
+
+	movers := TopMovers(deltas, 2)
+	assert.Len(t, movers, 2)
+	assert.Equal(t, []string{"databases-for-postgresql"}, movers[0].Key)
+	assert.Equal(t, []string{"messagehub"}, movers[1].Key)
+	assert.Len(t, TopMovers(deltas, -1), 3)
+}
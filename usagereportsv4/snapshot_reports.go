@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SnapshotReportMetadata : The metadata of a billing report snapshot, stored in the rows that precede the
+// header row of the report.
+type SnapshotReportMetadata struct {
+	// The ID of the account (or enterprise) of the report.
+	AccountID string `csv:"account_id,enterprise_id"`
+
+	// The name of the account (or enterprise) of the report.
+	AccountName string `csv:"account_name,enterprise_name"`
+
+	// The billing month of the report, in the format yyyy-mm.
+	Month string `csv:"month,billing_month"`
+
+	// The country code of the billing country.
+	BillingCountryCode string `csv:"billing_country_code,billing_country,country_code"`
+
+	// The currency code of the costs of the report.
+	BillingCurrencyCode string `csv:"billing_currency_code,billing_currency,currency_code,currency"`
+
+	// The rate applied to convert USD costs to the billing currency.
+	CurrencyRate float64 `csv:"currency_rate"`
+
+	// All the metadata values, by metadata column name.
+	Values map[string]string
+}
+
+// AccountSummaryReport : An account_summary report of a billing snapshot.
+type AccountSummaryReport struct {
+	Metadata SnapshotReportMetadata
+	Rows     []AccountSummaryReportRow
+}
+
+// AccountSummaryReportRow : The costs of a resource, or of a plan of a resource, in an account_summary report.
+type AccountSummaryReportRow struct {
+	ResourceID           string  `csv:"resource_id,service_id"`
+	ResourceName         string  `csv:"resource_name,service_name"`
+	PlanID               string  `csv:"plan_id"`
+	PlanName             string  `csv:"plan_name"`
+	BillableCost         float64 `csv:"billable_cost"`
+	BillableRatedCost    float64 `csv:"billable_rated_cost"`
+	NonBillableCost      float64 `csv:"non_billable_cost"`
+	NonBillableRatedCost float64 `csv:"non_billable_rated_cost"`
+}
+
+// EnterpriseSummaryReport : An enterprise_summary report of a billing snapshot.
+type EnterpriseSummaryReport struct {
+	Metadata SnapshotReportMetadata
+	Rows     []EnterpriseSummaryReportRow
+}
+
+// EnterpriseSummaryReportRow : The costs of a resource for an entity of an enterprise (the enterprise, an account group
+// or an account) in an enterprise_summary report.
+type EnterpriseSummaryReportRow struct {
+	EntityID             string  `csv:"entity_id"`
+	EntityType           string  `csv:"entity_type"`
+	EntityCRN            string  `csv:"entity_crn"`
+	EntityName           string  `csv:"entity_name"`
+	BillingUnitID        string  `csv:"billing_unit_id"`
+	ResourceID           string  `csv:"resource_id,service_id"`
+	ResourceName         string  `csv:"resource_name,service_name"`
+	BillableCost         float64 `csv:"billable_cost"`
+	BillableRatedCost    float64 `csv:"billable_rated_cost"`
+	NonBillableCost      float64 `csv:"non_billable_cost"`
+	NonBillableRatedCost float64 `csv:"non_billable_rated_cost"`
+}
+
+// AccountResourceInstanceUsageReport : An account_resource_instance_usage report of a billing snapshot.
+type AccountResourceInstanceUsageReport struct {
+	Metadata SnapshotReportMetadata
+	Rows     []AccountResourceInstanceUsageReportRow
+}
+
+// AccountResourceInstanceUsageReportRow : The usage of a metric by a resource instance in an
+// account_resource_instance_usage report. The Tags column lists the tags of the instance as a comma-separated value.
+type AccountResourceInstanceUsageReportRow struct {
+	AccountID            string   `csv:"account_id"`
+	ResourceInstanceID   string   `csv:"resource_instance_id,instance_id"`
+	ResourceInstanceName string   `csv:"resource_instance_name,instance_name"`
+	ResourceID           string   `csv:"resource_id,service_id"`
+	ResourceName         string   `csv:"resource_name,service_name"`
+	ResourceGroupID      string   `csv:"resource_group_id"`
+	ResourceGroupName    string   `csv:"resource_group_name"`
+	OrganizationID       string   `csv:"organization_id"`
+	SpaceID              string   `csv:"space_id"`
+	ConsumerID           string   `csv:"consumer_id"`
+	Region               string   `csv:"region"`
+	PricingRegion        string   `csv:"pricing_region"`
+	PricingCountry       string   `csv:"pricing_country"`
+	CurrencyCode         string   `csv:"currency_code,currency"`
+	Billable             bool     `csv:"billable"`
+	PlanID               string   `csv:"plan_id"`
+	PlanName             string   `csv:"plan_name"`
+	Month                string   `csv:"month"`
+	Metric               string   `csv:"metric,metric_id"`
+	MetricName           string   `csv:"metric_name"`
+	Unit                 string   `csv:"unit"`
+	UnitName             string   `csv:"unit_name"`
+	Quantity             float64  `csv:"quantity"`
+	RateableQuantity     float64  `csv:"rateable_quantity"`
+	Cost                 float64  `csv:"cost"`
+	RatedCost            float64  `csv:"rated_cost"`
+	Tags                 []string `csv:"tags"`
+}
+
+// ParseAccountSummaryReport parses an account_summary report of a billing snapshot.
+//
+// The report can be read from a local file or from a COS object, compressed with gzip or not. The parsers
+// match the columns of a report by name, regardless of their order, case and separators (e.g. "Resource ID" or
+// "resource_id"), ignore unknown columns and leave the fields of missing columns empty. The metadata rows
+// that precede the header row of a report are optional.
+func ParseAccountSummaryReport(r io.Reader) (report *AccountSummaryReport, err error) {
+	report = &AccountSummaryReport{}
+	err = parseSnapshotReport(r, &report.Metadata, &report.Rows, "resource_id,service_id")
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ParseEnterpriseSummaryReport parses an enterprise_summary report of a billing snapshot.
+// See ParseAccountSummaryReport for the supported formats.
+func ParseEnterpriseSummaryReport(r io.Reader) (report *EnterpriseSummaryReport, err error) {
+	report = &EnterpriseSummaryReport{}
+	err = parseSnapshotReport(r, &report.Metadata, &report.Rows, "entity_id", "resource_id,service_id")
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ParseAccountResourceInstanceUsageReport parses an account_resource_instance_usage report of a billing snapshot.
+// See ParseAccountSummaryReport for the supported formats.
+func ParseAccountResourceInstanceUsageReport(r io.Reader) (report *AccountResourceInstanceUsageReport, err error) {
+	report = &AccountResourceInstanceUsageReport{}
+	err = parseSnapshotReport(r, &report.Metadata, &report.Rows, "resource_instance_id,instance_id", "metric,metric_id")
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// parseSnapshotReport reads the metadata and the rows of a report. The report has a header row, which must contain
+// the "required" columns (each given as a comma-separated list of alternative names), and it can be preceded by a row of metadata column names and a row of metadata values.
+func parseSnapshotReport[T any](r io.Reader, metadata *SnapshotReportMetadata, rows *[]T, required ...string) error {
+	reader, err := uncompressedReader(r)
+	if err != nil {
+		return core.SDKErrorf(err, "", "snapshot-report-read-error", common.GetComponentInfo())
+	}
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return core.SDKErrorf(err, "the report has no header row", "snapshot-report-no-header", common.GetComponentInfo())
+	}
+	header = normalizeColumns(header)
+	metadata.Values = make(map[string]string)
+	if !containsColumns(header, required) {
+		values, err := csvReader.Read()
+		if err != nil {
+			return core.SDKErrorf(err, "the report has no metadata values row", "snapshot-report-no-metadata", common.GetComponentInfo())
+		}
+		for i, name := range header {
+			if i < len(values) && name != "" {
+				metadata.Values[name] = strings.TrimSpace(values[i])
+			}
+		}
+		err = decodeSnapshotRecord(header, values, metadata)
+		if err != nil {
+			return core.SDKErrorf(err, "invalid metadata row: "+err.Error(), "snapshot-report-invalid-metadata", common.GetComponentInfo())
+		}
+
+		header, err = csvReader.Read()
+		if err != nil {
+			return core.SDKErrorf(err, "the report has no header row", "snapshot-report-no-header", common.GetComponentInfo())
+		}
+		header = normalizeColumns(header)
+		if !containsColumns(header, required) {
+			return core.SDKErrorf(nil, fmt.Sprintf("the header row of the report must contain the columns %s", strings.Join(required, "; ")), "snapshot-report-missing-columns", common.GetComponentInfo())
+		}
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return core.SDKErrorf(err, "", "snapshot-report-read-error", common.GetComponentInfo())
+		}
+		var row T
+		err = decodeSnapshotRecord(header, record, &row)
+		if err != nil {
+			line, _ := csvReader.FieldPos(0)
+			return core.SDKErrorf(err, fmt.Sprintf("invalid row at line %d: %s", line, err.Error()), "snapshot-report-invalid-row", common.GetComponentInfo())
+		}
+		*rows = append(*rows, row)
+	}
+}
+
+// uncompressedReader returns a reader of the content of "r", which is uncompressed if it is in the gzip format,
+// without a UTF-8 byte order mark.
+func uncompressedReader(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	if magic, _ := buffered.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		buffered = bufio.NewReader(gzipReader)
+	}
+	if bom, _ := buffered.Peek(3); bytes.Equal(bom, []byte{0xef, 0xbb, 0xbf}) {
+		_, _ = buffered.Discard(3)
+	}
+	return buffered, nil
+}
+
+// normalizeColumn returns the canonical form of a column name: lower case words separated by underscores.
+func normalizeColumn(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "_")
+}
+
+// normalizeColumns returns the canonical form of the column names of a header row.
+func normalizeColumns(header []string) []string {
+	normalized := make([]string, len(header))
+	for i, name := range header {
+		normalized[i] = normalizeColumn(name)
+	}
+	return normalized
+}
+
+// containsColumns returns true if "header" contains one of the alternative names of each of the "columns".
+func containsColumns(header []string, columns []string) bool {
+	for _, column := range columns {
+		if !slices.ContainsFunc(strings.Split(column, ","), func(name string) bool {
+			return slices.Contains(header, name)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeSnapshotRecord sets the fields of the struct pointed to by "target" from the values of "record", using the
+// column names of the "csv" tags of the fields; the first name of a tag found in "header" is used.
+func decodeSnapshotRecord(header []string, record []string, target interface{}) error {
+	value := reflect.ValueOf(target).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		tag := field.Tag.Get("csv")
+		if tag == "" {
+			continue
+		}
+		index := -1
+		for _, name := range strings.Split(tag, ",") {
+			if index = slices.Index(header, name); index >= 0 {
+				break
+			}
+		}
+		if index < 0 || index >= len(record) {
+			continue
+		}
+
+		text := strings.TrimSpace(record[index])
+		if text == "" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.String:
+			value.Field(i).SetString(text)
+		case reflect.Float64:
+			f, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value '%s' of column '%s': %w", text, header[index], err)
+			}
+			value.Field(i).SetFloat(f)
+		case reflect.Bool:
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return fmt.Errorf("invalid value '%s' of column '%s': %w", text, header[index], err)
+			}
+			value.Field(i).SetBool(b)
+		case reflect.Slice:
+			var list []string
+			for _, element := range strings.Split(text, ",") {
+				if element = strings.TrimSpace(element); element != "" {
+					list = append(list, element)
+				}
+			}
+			value.Field(i).Set(reflect.ValueOf(list))
+		}
+	}
+	return nil
+}
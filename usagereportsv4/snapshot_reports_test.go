@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usagereportsv4_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Snapshot report parsers`, func() {
+	var files []*os.File
+
+	openReport := func(name string) *os.File {
+		file, err := os.Open("testdata/" + name)
+		Expect(err).To(BeNil())
+		files = append(files, file)
+		return file
+	}
+
+	AfterEach(func() {
+		for _, file := range files {
+			file.Close()
+		}
+		files = nil
+	})
+
+	It(`Parse an account summary report`, func() {
+		report, err := usagereportsv4.ParseAccountSummaryReport(openReport("account_summary.csv"))
+		Expect(err).To(BeNil())
+		Expect(report.Metadata.AccountID).To(Equal("0f0d6b0e2a1f4c2f9a4d5e6f7a8b9c0d"))
+		Expect(report.Metadata.AccountName).To(Equal("Production"))
+		Expect(report.Metadata.Month).To(Equal("2025-05"))
+		Expect(report.Metadata.BillingCountryCode).To(Equal("USA"))
+		Expect(report.Metadata.BillingCurrencyCode).To(Equal("USD"))
+		Expect(report.Metadata.CurrencyRate).To(Equal(1.0))
+		Expect(report.Metadata.Values).To(HaveKeyWithValue("billing_month", "2025-05"))
+
+		Expect(report.Rows).To(HaveLen(3))
+		Expect(report.Rows[1]).To(Equal(usagereportsv4.AccountSummaryReportRow{
+			ResourceID:           "kms",
+			ResourceName:         "Key Protect",
+			PlanID:               "eedd3585-90c6-4c8f-be3d-062069e99fc3",
+			PlanName:             "Tiered Pricing",
+			BillableCost:         10,
+			BillableRatedCost:    12.25,
+			NonBillableCost:      3.5,
+			NonBillableRatedCost: 3.5,
+		}))
+		Expect(report.Rows[2].ResourceName).To(Equal("Kubernetes Service, VPC"))
+		Expect(report.Rows[2].BillableCost).To(Equal(1024.75))
+		Expect(report.Rows[2].NonBillableCost).To(BeZero())
+	})
+	It(`Parse an enterprise summary report`, func() {
+		report, err := usagereportsv4.ParseEnterpriseSummaryReport(openReport("enterprise_summary.csv"))
+		Expect(err).To(BeNil())
+		Expect(report.Metadata.AccountID).To(Equal("5a3f8c1e9b2d4e7f8a6b1c3d5e7f9a2b"))
+		Expect(report.Metadata.AccountName).To(Equal("Example Corp"))
+		Expect(report.Metadata.BillingCurrencyCode).To(Equal("EUR"))
+
+		Expect(report.Rows).To(HaveLen(3))
+		Expect(report.Rows[1].EntityType).To(Equal("account-group"))
+		Expect(report.Rows[1].EntityName).To(Equal("Engineering"))
+		Expect(report.Rows[2].EntityCRN).To(HavePrefix("crn:v1:bluemix:public:enterprise::"))
+		Expect(report.Rows[2].BillingUnitID).To(Equal("bu-1"))
+		Expect(report.Rows[2].BillableRatedCost).To(Equal(15.5))
+	})
+	It(`Parse an account resource instance usage report`, func() {
+		report, err := usagereportsv4.ParseAccountResourceInstanceUsageReport(openReport("account_resource_instance_usage.csv"))
+		Expect(err).To(BeNil())
+		Expect(report.Metadata.Month).To(Equal("2025-05"))
+
+		Expect(report.Rows).To(HaveLen(3))
+		Expect(report.Rows[0].ResourceInstanceID).To(Equal("crn:v1:bluemix:public:cloud-object-storage:global:a/0f0d:1111::"))
+		Expect(report.Rows[0].Metric).To(Equal("STANDARD_STORAGE"))
+		Expect(report.Rows[0].Quantity).To(Equal(5000.0))
+		Expect(report.Rows[0].Cost).To(Equal(110.5))
+		Expect(report.Rows[0].Billable).To(BeTrue())
+		Expect(report.Rows[0].Tags).To(Equal([]string{"env:prod", "team:storage"}))
+		Expect(report.Rows[2].Tags).To(BeNil())
+		Expect(report.Rows[2].ResourceGroupName).To(Equal("security"))
+		Expect(report.Rows[2].Billable).To(BeFalse())
+		Expect(report.Rows[2].RateableQuantity).To(Equal(7.0))
+	})
+	It(`Parse a compressed report`, func() {
+		data, err := os.ReadFile("testdata/account_summary.csv")
+		Expect(err).To(BeNil())
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err = writer.Write(data)
+		Expect(err).To(BeNil())
+		Expect(writer.Close()).To(Succeed())
+
+		report, err := usagereportsv4.ParseAccountSummaryReport(&compressed)
+		Expect(err).To(BeNil())
+		Expect(report.Rows).To(HaveLen(3))
+	})
+	It(`Parse a report without metadata rows`, func() {
+		csv := "\ufeffservice_id,service_name,billable_cost\ncloud-object-storage,Cloud Object Storage,1.5\n"
+		report, err := usagereportsv4.ParseAccountSummaryReport(strings.NewReader(csv))
+		Expect(err).To(BeNil())
+		Expect(report.Metadata.Values).To(BeEmpty())
+		Expect(report.Rows).To(Equal([]usagereportsv4.AccountSummaryReportRow{
+			{ResourceID: "cloud-object-storage", ResourceName: "Cloud Object Storage", BillableCost: 1.5},
+		}))
+	})
+	It(`Fail to parse invalid reports`, func() {
+		_, err := usagereportsv4.ParseAccountSummaryReport(strings.NewReader(""))
+		Expect(err).ToNot(BeNil())
+
+		_, err = usagereportsv4.ParseAccountSummaryReport(strings.NewReader("Account ID\nabc\nName,Cost\n"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("must contain the columns resource_id,service_id"))
+
+		_, err = usagereportsv4.ParseAccountSummaryReport(strings.NewReader("Resource ID,Billable Cost\nkms,free\n"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("line 2"))
+		Expect(err.Error()).To(ContainSubstring("billable_cost"))
+
+		_, err = usagereportsv4.ParseAccountResourceInstanceUsageReport(openReport("account_summary.csv"))
+		Expect(err).ToNot(BeNil())
+	})
+})
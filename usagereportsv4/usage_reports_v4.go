@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -4488,6 +4489,13 @@ func (pager *GetResourceUsageAccountPager) GetAll() (allItems []InstanceUsage, e
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetResourceUsageAccountPager) Items(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.Items[InstanceUsage](ctx, pager)
+}
+
 //
 // GetResourceUsageResourceGroupPager can be used to simplify the use of the "GetResourceUsageResourceGroup" method.
 //
@@ -4582,6 +4590,13 @@ func (pager *GetResourceUsageResourceGroupPager) GetAll() (allItems []InstanceUs
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetResourceUsageResourceGroupPager) Items(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.Items[InstanceUsage](ctx, pager)
+}
+
 //
 // GetResourceUsageOrgPager can be used to simplify the use of the "GetResourceUsageOrg" method.
 //
@@ -4676,6 +4691,13 @@ func (pager *GetResourceUsageOrgPager) GetAll() (allItems []InstanceUsage, err e
 	return
 }
 
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetResourceUsageOrgPager) Items(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.Items[InstanceUsage](ctx, pager)
+}
+
 //
 // GetReportsSnapshotPager can be used to simplify the use of the "GetReportsSnapshot" method.
 //
@@ -4769,3 +4791,10 @@ func (pager *GetReportsSnapshotPager) GetAll() (allItems []SnapshotListSnapshots
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *GetReportsSnapshotPager) Items(ctx context.Context) iter.Seq2[SnapshotListSnapshotsItem, error] {
+	return common.Items[SnapshotListSnapshotsItem](ctx, pager)
+}
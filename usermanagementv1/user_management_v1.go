@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -1745,3 +1746,10 @@ func (pager *UsersPager) GetNext() (page []UserProfile, err error) {
 func (pager *UsersPager) GetAll() (allItems []UserProfile, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// Items returns an iterator over all results, retrieving pages on demand using the specified Context.
+// The iteration stops when all pages have been retrieved, when the caller stops ranging over it,
+// or when an error (including cancellation of the Context) is encountered.
+func (pager *UsersPager) Items(ctx context.Context) iter.Seq2[UserProfile, error] {
+	return common.Items[UserProfile](ctx, pager)
+}
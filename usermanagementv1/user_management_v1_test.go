@@ -516,6 +516,33 @@ var _ = Describe(`UserManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use UsersPager.Items successfully`, func() {
+				userManagementService, serviceErr := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userManagementService).ToNot(BeNil())
+
+				listUsersOptionsModel := &usermanagementv1.ListUsersOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeSettings: core.BoolPtr(true),
+					Search: core.StringPtr("testString"),
+					UserID: core.StringPtr("testString"),
+				}
+
+				pager, err := userManagementService.NewUsersPager(listUsersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usermanagementv1.UserProfile
+				for item, err := range pager.Items(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`InviteUsers(inviteUsersOptions *InviteUsersOptions) - Operation response error`, func() {